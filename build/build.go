@@ -0,0 +1,353 @@
+// Package build provides constructors for dst nodes with chainable methods to add decorations:
+//
+//	build.Expr(build.Call(build.Qual("fmt", "Println"), build.Str("hi"))).
+//		WithComment("// say hi").
+//		NewLineBefore().
+//		Build()
+//
+// Each node type has a corresponding builder type that holds the dst node in its Node field. The
+// constructors take ExprBuilder and StmtBuilder arguments, so builders can be passed to them
+// directly. Use Node and StmtNode to pass an existing dst node, and the Build method to get the
+// node from a builder (e.g. to add it to a tree).
+//
+// Qualified identifiers created with Qual have Ident.Path set, so the import block is updated
+// automatically when the tree is restored with a Restorer that has import management enabled.
+package build
+
+import (
+	"go/token"
+	"strconv"
+
+	"github.com/dave/dst"
+)
+
+// ExprBuilder is implemented by the builders of expressions, and by the result of Node.
+type ExprBuilder interface {
+	BuildExpr() dst.Expr
+}
+
+// StmtBuilder is implemented by the builders of statements, and by the result of StmtNode.
+type StmtBuilder interface {
+	BuildStmt() dst.Stmt
+}
+
+// Node returns an ExprBuilder for an existing expression, so it can be passed to the constructors.
+func Node(x dst.Expr) ExprBuilder {
+	return exprNode{x}
+}
+
+// StmtNode returns a StmtBuilder for an existing statement, so it can be passed to the
+// constructors.
+func StmtNode(s dst.Stmt) StmtBuilder {
+	return stmtNode{s}
+}
+
+type exprNode struct{ x dst.Expr }
+
+func (n exprNode) BuildExpr() dst.Expr { return n.x }
+
+type stmtNode struct{ s dst.Stmt }
+
+func (n stmtNode) BuildStmt() dst.Stmt { return n.s }
+
+// expr builds b, or returns nil if b is nil.
+func expr(b ExprBuilder) dst.Expr {
+	if b == nil {
+		return nil
+	}
+	return b.BuildExpr()
+}
+
+func exprs(bs []ExprBuilder) []dst.Expr {
+	var out []dst.Expr
+	for _, b := range bs {
+		out = append(out, expr(b))
+	}
+	return out
+}
+
+// stmt builds b, or returns nil if b is nil.
+func stmt(b StmtBuilder) dst.Stmt {
+	if b == nil {
+		return nil
+	}
+	return b.BuildStmt()
+}
+
+func stmts(bs []StmtBuilder) []dst.Stmt {
+	var out []dst.Stmt
+	for _, b := range bs {
+		out = append(out, stmt(b))
+	}
+	return out
+}
+
+func callExpr(e dst.Expr) *dst.CallExpr {
+	c, ok := e.(*dst.CallExpr)
+	if !ok {
+		panic("build: expected a call expression")
+	}
+	return c
+}
+
+// Id returns a local identifier.
+func Id(name string) *Ident {
+	return &Ident{Node: &dst.Ident{Name: name}}
+}
+
+// Qual returns a qualified identifier: name in the package with import path.
+func Qual(path, name string) *Ident {
+	return &Ident{Node: &dst.Ident{Name: name, Path: path}}
+}
+
+// Nil returns the nil identifier.
+func Nil() *Ident {
+	return Id("nil")
+}
+
+// Literal returns a basic literal with kind and value.
+func Literal(kind token.Token, value string) *BasicLit {
+	return &BasicLit{Node: &dst.BasicLit{Kind: kind, Value: value}}
+}
+
+// Str returns a quoted string literal.
+func Str(s string) *BasicLit {
+	return Literal(token.STRING, strconv.Quote(s))
+}
+
+// Int returns an integer literal.
+func Int(i int) *BasicLit {
+	return Literal(token.INT, strconv.Itoa(i))
+}
+
+// Float returns a floating-point literal.
+func Float(f float64) *BasicLit {
+	return Literal(token.FLOAT, strconv.FormatFloat(f, 'g', -1, 64))
+}
+
+// Char returns a character literal.
+func Char(r rune) *BasicLit {
+	return Literal(token.CHAR, strconv.QuoteRune(r))
+}
+
+// Call returns a call expression.
+func Call(fun ExprBuilder, args ...ExprBuilder) *CallExpr {
+	return &CallExpr{Node: &dst.CallExpr{Fun: expr(fun), Args: exprs(args)}}
+}
+
+// Sel returns a selector expression: x.sel.
+func Sel(x ExprBuilder, sel string) *SelectorExpr {
+	return &SelectorExpr{Node: &dst.SelectorExpr{X: expr(x), Sel: dst.NewIdent(sel)}}
+}
+
+// Index returns an index expression: x[index].
+func Index(x, index ExprBuilder) *IndexExpr {
+	return &IndexExpr{Node: &dst.IndexExpr{X: expr(x), Index: expr(index)}}
+}
+
+// Star returns a star expression: *x.
+func Star(x ExprBuilder) *StarExpr {
+	return &StarExpr{Node: &dst.StarExpr{X: expr(x)}}
+}
+
+// Addr returns a unary expression taking the address of x: &x.
+func Addr(x ExprBuilder) *UnaryExpr {
+	return Unary(token.AND, x)
+}
+
+// Not returns a unary expression: !x.
+func Not(x ExprBuilder) *UnaryExpr {
+	return Unary(token.NOT, x)
+}
+
+// Unary returns a unary expression.
+func Unary(op token.Token, x ExprBuilder) *UnaryExpr {
+	return &UnaryExpr{Node: &dst.UnaryExpr{Op: op, X: expr(x)}}
+}
+
+// Binary returns a binary expression.
+func Binary(x ExprBuilder, op token.Token, y ExprBuilder) *BinaryExpr {
+	return &BinaryExpr{Node: &dst.BinaryExpr{X: expr(x), Op: op, Y: expr(y)}}
+}
+
+// Paren returns a parenthesized expression: (x).
+func Paren(x ExprBuilder) *ParenExpr {
+	return &ParenExpr{Node: &dst.ParenExpr{X: expr(x)}}
+}
+
+// Composite returns a composite literal. The type may be nil.
+func Composite(typ ExprBuilder, elts ...ExprBuilder) *CompositeLit {
+	return &CompositeLit{Node: &dst.CompositeLit{Type: expr(typ), Elts: exprs(elts)}}
+}
+
+// KeyValue returns a key-value pair for use in composite literals.
+func KeyValue(key, value ExprBuilder) *KeyValueExpr {
+	return &KeyValueExpr{Node: &dst.KeyValueExpr{Key: expr(key), Value: expr(value)}}
+}
+
+// TypeAssert returns a type assertion: x.(typ).
+func TypeAssert(x, typ ExprBuilder) *TypeAssertExpr {
+	return &TypeAssertExpr{Node: &dst.TypeAssertExpr{X: expr(x), Type: expr(typ)}}
+}
+
+// SliceOf returns a slice type: []elt.
+func SliceOf(elt ExprBuilder) *ArrayType {
+	return &ArrayType{Node: &dst.ArrayType{Elt: expr(elt)}}
+}
+
+// ArrayOf returns an array type: [length]elt.
+func ArrayOf(length, elt ExprBuilder) *ArrayType {
+	return &ArrayType{Node: &dst.ArrayType{Len: expr(length), Elt: expr(elt)}}
+}
+
+// MapOf returns a map type: map[key]value.
+func MapOf(key, value ExprBuilder) *MapType {
+	return &MapType{Node: &dst.MapType{Key: expr(key), Value: expr(value)}}
+}
+
+// ChanOf returns a channel type.
+func ChanOf(dir dst.ChanDir, value ExprBuilder) *ChanType {
+	return &ChanType{Node: &dst.ChanType{Dir: dir, Value: expr(value)}}
+}
+
+// Param returns a field for use in parameter, result and struct field lists. The name may be
+// empty.
+func Param(name string, typ ExprBuilder) *Field {
+	f := &dst.Field{Type: expr(typ)}
+	if name != "" {
+		f.Names = []*dst.Ident{dst.NewIdent(name)}
+	}
+	return &Field{Node: f}
+}
+
+// Params returns a parenthesized field list.
+func Params(fields ...*Field) *FieldList {
+	l := &dst.FieldList{Opening: true, Closing: true}
+	for _, f := range fields {
+		l.List = append(l.List, f.Build())
+	}
+	return &FieldList{Node: l}
+}
+
+// FuncSig returns a function type. Params and results may be nil.
+func FuncSig(params, results *FieldList) *FuncType {
+	t := &dst.FuncType{Func: true, Params: &dst.FieldList{Opening: true, Closing: true}}
+	if params != nil {
+		t.Params = params.Build()
+	}
+	if results != nil {
+		t.Results = results.Build()
+	}
+	return &FuncType{Node: t}
+}
+
+// Closure returns a function literal.
+func Closure(params, results *FieldList, body ...StmtBuilder) *FuncLit {
+	return &FuncLit{Node: &dst.FuncLit{Type: FuncSig(params, results).Build(), Body: Block(body...).Build()}}
+}
+
+// Expr returns an expression statement.
+func Expr(x ExprBuilder) *ExprStmt {
+	return &ExprStmt{Node: &dst.ExprStmt{X: expr(x)}}
+}
+
+// Assign returns an assignment statement: lhs = rhs.
+func Assign(lhs, rhs ExprBuilder) *AssignStmt {
+	return &AssignStmt{Node: &dst.AssignStmt{Lhs: []dst.Expr{expr(lhs)}, Tok: token.ASSIGN, Rhs: []dst.Expr{expr(rhs)}}}
+}
+
+// Define returns a short variable declaration: lhs := rhs.
+func Define(lhs, rhs ExprBuilder) *AssignStmt {
+	return &AssignStmt{Node: &dst.AssignStmt{Lhs: []dst.Expr{expr(lhs)}, Tok: token.DEFINE, Rhs: []dst.Expr{expr(rhs)}}}
+}
+
+// Inc returns an increment statement: x++.
+func Inc(x ExprBuilder) *IncDecStmt {
+	return &IncDecStmt{Node: &dst.IncDecStmt{X: expr(x), Tok: token.INC}}
+}
+
+// Return returns a return statement.
+func Return(results ...ExprBuilder) *ReturnStmt {
+	return &ReturnStmt{Node: &dst.ReturnStmt{Results: exprs(results)}}
+}
+
+// Go returns a go statement. The call must be a call expression.
+func Go(call ExprBuilder) *GoStmt {
+	return &GoStmt{Node: &dst.GoStmt{Call: callExpr(expr(call))}}
+}
+
+// Defer returns a defer statement. The call must be a call expression.
+func Defer(call ExprBuilder) *DeferStmt {
+	return &DeferStmt{Node: &dst.DeferStmt{Call: callExpr(expr(call))}}
+}
+
+// Block returns a block statement. Statements with no line spacing are given a NewLine before
+// and after so each statement is rendered on its own line.
+func Block(list ...StmtBuilder) *BlockStmt {
+	b := &dst.BlockStmt{List: stmts(list)}
+	for _, s := range b.List {
+		decs := s.Decorations()
+		if decs.Before == dst.None {
+			decs.Before = dst.NewLine
+		}
+		if decs.After == dst.None {
+			decs.After = dst.NewLine
+		}
+	}
+	return &BlockStmt{Node: b}
+}
+
+// If returns an if statement.
+func If(cond ExprBuilder, body ...StmtBuilder) *IfStmt {
+	return &IfStmt{Node: &dst.IfStmt{Cond: expr(cond), Body: Block(body...).Build()}}
+}
+
+// For returns a for statement. Init, cond and post may be nil.
+func For(init StmtBuilder, cond ExprBuilder, post StmtBuilder, body ...StmtBuilder) *ForStmt {
+	return &ForStmt{Node: &dst.ForStmt{Init: stmt(init), Cond: expr(cond), Post: stmt(post), Body: Block(body...).Build()}}
+}
+
+// Range returns a for statement with a range clause. Key and value may be nil.
+func Range(key, value ExprBuilder, tok token.Token, x ExprBuilder, body ...StmtBuilder) *RangeStmt {
+	s := &dst.RangeStmt{Key: expr(key), Value: expr(value), X: expr(x), Body: Block(body...).Build()}
+	if s.Key != nil {
+		s.Tok = tok
+	}
+	return &RangeStmt{Node: s}
+}
+
+// Var returns a var declaration. Either typ or value may be nil.
+func Var(name string, typ, value ExprBuilder) *GenDecl {
+	return valueDecl(token.VAR, name, typ, value)
+}
+
+// Const returns a const declaration. Typ may be nil.
+func Const(name string, typ, value ExprBuilder) *GenDecl {
+	return valueDecl(token.CONST, name, typ, value)
+}
+
+func valueDecl(tok token.Token, name string, typ, value ExprBuilder) *GenDecl {
+	spec := &dst.ValueSpec{Names: []*dst.Ident{dst.NewIdent(name)}, Type: expr(typ)}
+	if value != nil {
+		spec.Values = []dst.Expr{expr(value)}
+	}
+	return &GenDecl{Node: &dst.GenDecl{Tok: tok, Specs: []dst.Spec{spec}}}
+}
+
+// Type returns a type declaration.
+func Type(name string, typ ExprBuilder) *GenDecl {
+	spec := &dst.TypeSpec{Name: dst.NewIdent(name), Type: expr(typ)}
+	return &GenDecl{Node: &dst.GenDecl{Tok: token.TYPE, Specs: []dst.Spec{spec}}}
+}
+
+// Func returns a function declaration. Params and results may be nil.
+func Func(name string, params, results *FieldList, body ...StmtBuilder) *FuncDecl {
+	return &FuncDecl{Node: &dst.FuncDecl{Name: dst.NewIdent(name), Type: FuncSig(params, results).Build(), Body: Block(body...).Build()}}
+}
+
+// Method returns a method declaration. Params and results may be nil.
+func Method(recv *Field, name string, params, results *FieldList, body ...StmtBuilder) *FuncDecl {
+	f := Func(name, params, results, body...)
+	f.Node.Recv = Params(recv).Build()
+	return f
+}
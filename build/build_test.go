@@ -0,0 +1,81 @@
+package build_test
+
+import (
+	"bytes"
+	"go/token"
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/build"
+	"github.com/dave/dst/decorator"
+	"github.com/dave/dst/decorator/resolver/guess"
+)
+
+func TestBuild(t *testing.T) {
+	f := &dst.File{
+		Name: dst.NewIdent("main"),
+		Decls: []dst.Decl{
+			build.Var("n", nil, build.Int(1)).EmptyLineBefore().Build(),
+			build.Func("main", nil, nil,
+				build.Expr(build.Call(build.Qual("fmt", "Println"), build.Str("hi"))).WithComment("// say hi"),
+				build.Range(build.Id("i"), nil, token.DEFINE, build.Call(build.Qual("strings", "Fields"), build.Str("a b")),
+					build.Expr(build.Call(build.Qual("fmt", "Println"), build.Id("i"))).WithEndComment("// print"),
+				).EmptyLineBefore(),
+				build.If(build.Binary(build.Id("n"), token.GTR, build.Int(0)),
+					build.StmtNode(&dst.ReturnStmt{}),
+				).NewLineBefore(),
+			).EmptyLineBefore().Build(),
+			build.Method(build.Param("t", build.Star(build.Id("T"))), "String", nil, build.Params(build.Param("", build.Id("string"))),
+				build.Return(build.Call(build.Qual("fmt", "Sprint"), build.Composite(build.SliceOf(build.Id("int")), build.Int(1), build.Node(&dst.BasicLit{Kind: token.INT, Value: "2"})))),
+			).EmptyLineBefore().WithComment("// String returns a string").Build(),
+			build.Type("T", build.Id("int")).EmptyLineBefore().Build(),
+		},
+	}
+
+	expect := `package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+var n = 1
+
+func main() {
+	// say hi
+	fmt.Println("hi")
+
+	for i := range strings.Fields("a b") {
+		fmt.Println(i) // print
+	}
+	if n > 0 {
+		return
+	}
+}
+
+// String returns a string
+func (t *T) String() string {
+	return fmt.Sprint([]int{1, 2})
+}
+
+type T int
+`
+	buf := &bytes.Buffer{}
+	if err := decorator.NewRestorerWithImports("main", guess.New()).Fprint(buf, f); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != expect {
+		t.Errorf("expected:\n%s\nfound:\n%s", expect, buf.String())
+	}
+}
+
+func TestBuild_Node(t *testing.T) {
+	b := build.Call(build.Id("a"))
+	if b.Build() != b.Node {
+		t.Error("expected Build to return the node")
+	}
+	var n interface{} = b
+	if _, ok := n.(dst.Node); ok {
+		t.Error("expected builder not to be a dst.Node")
+	}
+}
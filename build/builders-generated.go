@@ -0,0 +1,3482 @@
+package build
+
+import "github.com/dave/dst"
+
+// ArrayType holds a *dst.ArrayType with chainable methods to set decorations. Use Build to get the
+// *dst.ArrayType.
+type ArrayType struct {
+	Node *dst.ArrayType
+}
+
+// Build returns the *dst.ArrayType.
+func (b *ArrayType) Build() *dst.ArrayType {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.ArrayType. It implements ExprBuilder.
+func (b *ArrayType) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ArrayType) WithComment(comments ...string) *ArrayType {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ArrayType) WithEndComment(comments ...string) *ArrayType {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *ArrayType) NewLineBefore() *ArrayType {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *ArrayType) EmptyLineBefore() *ArrayType {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *ArrayType) NewLineAfter() *ArrayType {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *ArrayType) EmptyLineAfter() *ArrayType {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateLbrack appends decorations to the Lbrack decorations.
+func (b *ArrayType) DecorateLbrack(decs ...string) *ArrayType {
+	b.Node.Decs.Lbrack.Append(decs...)
+	return b
+}
+
+// DecorateLen appends decorations to the Len decorations.
+func (b *ArrayType) DecorateLen(decs ...string) *ArrayType {
+	b.Node.Decs.Len.Append(decs...)
+	return b
+}
+
+// AssignStmt holds a *dst.AssignStmt with chainable methods to set decorations. Use Build to get the
+// *dst.AssignStmt.
+type AssignStmt struct {
+	Node *dst.AssignStmt
+}
+
+// Build returns the *dst.AssignStmt.
+func (b *AssignStmt) Build() *dst.AssignStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.AssignStmt. It implements StmtBuilder.
+func (b *AssignStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *AssignStmt) WithComment(comments ...string) *AssignStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *AssignStmt) WithEndComment(comments ...string) *AssignStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *AssignStmt) NewLineBefore() *AssignStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *AssignStmt) EmptyLineBefore() *AssignStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *AssignStmt) NewLineAfter() *AssignStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *AssignStmt) EmptyLineAfter() *AssignStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateTok appends decorations to the Tok decorations.
+func (b *AssignStmt) DecorateTok(decs ...string) *AssignStmt {
+	b.Node.Decs.Tok.Append(decs...)
+	return b
+}
+
+// BadDecl holds a *dst.BadDecl with chainable methods to set decorations. Use Build to get the
+// *dst.BadDecl.
+type BadDecl struct {
+	Node *dst.BadDecl
+}
+
+// Build returns the *dst.BadDecl.
+func (b *BadDecl) Build() *dst.BadDecl {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *BadDecl) WithComment(comments ...string) *BadDecl {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *BadDecl) WithEndComment(comments ...string) *BadDecl {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *BadDecl) NewLineBefore() *BadDecl {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *BadDecl) EmptyLineBefore() *BadDecl {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *BadDecl) NewLineAfter() *BadDecl {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *BadDecl) EmptyLineAfter() *BadDecl {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// BadExpr holds a *dst.BadExpr with chainable methods to set decorations. Use Build to get the
+// *dst.BadExpr.
+type BadExpr struct {
+	Node *dst.BadExpr
+}
+
+// Build returns the *dst.BadExpr.
+func (b *BadExpr) Build() *dst.BadExpr {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.BadExpr. It implements ExprBuilder.
+func (b *BadExpr) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *BadExpr) WithComment(comments ...string) *BadExpr {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *BadExpr) WithEndComment(comments ...string) *BadExpr {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *BadExpr) NewLineBefore() *BadExpr {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *BadExpr) EmptyLineBefore() *BadExpr {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *BadExpr) NewLineAfter() *BadExpr {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *BadExpr) EmptyLineAfter() *BadExpr {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// BadStmt holds a *dst.BadStmt with chainable methods to set decorations. Use Build to get the
+// *dst.BadStmt.
+type BadStmt struct {
+	Node *dst.BadStmt
+}
+
+// Build returns the *dst.BadStmt.
+func (b *BadStmt) Build() *dst.BadStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.BadStmt. It implements StmtBuilder.
+func (b *BadStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *BadStmt) WithComment(comments ...string) *BadStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *BadStmt) WithEndComment(comments ...string) *BadStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *BadStmt) NewLineBefore() *BadStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *BadStmt) EmptyLineBefore() *BadStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *BadStmt) NewLineAfter() *BadStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *BadStmt) EmptyLineAfter() *BadStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// BasicLit holds a *dst.BasicLit with chainable methods to set decorations. Use Build to get the
+// *dst.BasicLit.
+type BasicLit struct {
+	Node *dst.BasicLit
+}
+
+// Build returns the *dst.BasicLit.
+func (b *BasicLit) Build() *dst.BasicLit {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.BasicLit. It implements ExprBuilder.
+func (b *BasicLit) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *BasicLit) WithComment(comments ...string) *BasicLit {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *BasicLit) WithEndComment(comments ...string) *BasicLit {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *BasicLit) NewLineBefore() *BasicLit {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *BasicLit) EmptyLineBefore() *BasicLit {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *BasicLit) NewLineAfter() *BasicLit {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *BasicLit) EmptyLineAfter() *BasicLit {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// BinaryExpr holds a *dst.BinaryExpr with chainable methods to set decorations. Use Build to get the
+// *dst.BinaryExpr.
+type BinaryExpr struct {
+	Node *dst.BinaryExpr
+}
+
+// Build returns the *dst.BinaryExpr.
+func (b *BinaryExpr) Build() *dst.BinaryExpr {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.BinaryExpr. It implements ExprBuilder.
+func (b *BinaryExpr) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *BinaryExpr) WithComment(comments ...string) *BinaryExpr {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *BinaryExpr) WithEndComment(comments ...string) *BinaryExpr {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *BinaryExpr) NewLineBefore() *BinaryExpr {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *BinaryExpr) EmptyLineBefore() *BinaryExpr {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *BinaryExpr) NewLineAfter() *BinaryExpr {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *BinaryExpr) EmptyLineAfter() *BinaryExpr {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateX appends decorations to the X decorations.
+func (b *BinaryExpr) DecorateX(decs ...string) *BinaryExpr {
+	b.Node.Decs.X.Append(decs...)
+	return b
+}
+
+// DecorateOp appends decorations to the Op decorations.
+func (b *BinaryExpr) DecorateOp(decs ...string) *BinaryExpr {
+	b.Node.Decs.Op.Append(decs...)
+	return b
+}
+
+// BlockStmt holds a *dst.BlockStmt with chainable methods to set decorations. Use Build to get the
+// *dst.BlockStmt.
+type BlockStmt struct {
+	Node *dst.BlockStmt
+}
+
+// Build returns the *dst.BlockStmt.
+func (b *BlockStmt) Build() *dst.BlockStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.BlockStmt. It implements StmtBuilder.
+func (b *BlockStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *BlockStmt) WithComment(comments ...string) *BlockStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *BlockStmt) WithEndComment(comments ...string) *BlockStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *BlockStmt) NewLineBefore() *BlockStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *BlockStmt) EmptyLineBefore() *BlockStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *BlockStmt) NewLineAfter() *BlockStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *BlockStmt) EmptyLineAfter() *BlockStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateLbrace appends decorations to the Lbrace decorations.
+func (b *BlockStmt) DecorateLbrace(decs ...string) *BlockStmt {
+	b.Node.Decs.Lbrace.Append(decs...)
+	return b
+}
+
+// BranchStmt holds a *dst.BranchStmt with chainable methods to set decorations. Use Build to get the
+// *dst.BranchStmt.
+type BranchStmt struct {
+	Node *dst.BranchStmt
+}
+
+// Build returns the *dst.BranchStmt.
+func (b *BranchStmt) Build() *dst.BranchStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.BranchStmt. It implements StmtBuilder.
+func (b *BranchStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *BranchStmt) WithComment(comments ...string) *BranchStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *BranchStmt) WithEndComment(comments ...string) *BranchStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *BranchStmt) NewLineBefore() *BranchStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *BranchStmt) EmptyLineBefore() *BranchStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *BranchStmt) NewLineAfter() *BranchStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *BranchStmt) EmptyLineAfter() *BranchStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateTok appends decorations to the Tok decorations.
+func (b *BranchStmt) DecorateTok(decs ...string) *BranchStmt {
+	b.Node.Decs.Tok.Append(decs...)
+	return b
+}
+
+// CallExpr holds a *dst.CallExpr with chainable methods to set decorations. Use Build to get the
+// *dst.CallExpr.
+type CallExpr struct {
+	Node *dst.CallExpr
+}
+
+// Build returns the *dst.CallExpr.
+func (b *CallExpr) Build() *dst.CallExpr {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.CallExpr. It implements ExprBuilder.
+func (b *CallExpr) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *CallExpr) WithComment(comments ...string) *CallExpr {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *CallExpr) WithEndComment(comments ...string) *CallExpr {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *CallExpr) NewLineBefore() *CallExpr {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *CallExpr) EmptyLineBefore() *CallExpr {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *CallExpr) NewLineAfter() *CallExpr {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *CallExpr) EmptyLineAfter() *CallExpr {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateFun appends decorations to the Fun decorations.
+func (b *CallExpr) DecorateFun(decs ...string) *CallExpr {
+	b.Node.Decs.Fun.Append(decs...)
+	return b
+}
+
+// DecorateLparen appends decorations to the Lparen decorations.
+func (b *CallExpr) DecorateLparen(decs ...string) *CallExpr {
+	b.Node.Decs.Lparen.Append(decs...)
+	return b
+}
+
+// DecorateEllipsis appends decorations to the Ellipsis decorations.
+func (b *CallExpr) DecorateEllipsis(decs ...string) *CallExpr {
+	b.Node.Decs.Ellipsis.Append(decs...)
+	return b
+}
+
+// CaseClause holds a *dst.CaseClause with chainable methods to set decorations. Use Build to get the
+// *dst.CaseClause.
+type CaseClause struct {
+	Node *dst.CaseClause
+}
+
+// Build returns the *dst.CaseClause.
+func (b *CaseClause) Build() *dst.CaseClause {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.CaseClause. It implements StmtBuilder.
+func (b *CaseClause) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *CaseClause) WithComment(comments ...string) *CaseClause {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *CaseClause) WithEndComment(comments ...string) *CaseClause {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *CaseClause) NewLineBefore() *CaseClause {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *CaseClause) EmptyLineBefore() *CaseClause {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *CaseClause) NewLineAfter() *CaseClause {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *CaseClause) EmptyLineAfter() *CaseClause {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateCase appends decorations to the Case decorations.
+func (b *CaseClause) DecorateCase(decs ...string) *CaseClause {
+	b.Node.Decs.Case.Append(decs...)
+	return b
+}
+
+// DecorateColon appends decorations to the Colon decorations.
+func (b *CaseClause) DecorateColon(decs ...string) *CaseClause {
+	b.Node.Decs.Colon.Append(decs...)
+	return b
+}
+
+// ChanType holds a *dst.ChanType with chainable methods to set decorations. Use Build to get the
+// *dst.ChanType.
+type ChanType struct {
+	Node *dst.ChanType
+}
+
+// Build returns the *dst.ChanType.
+func (b *ChanType) Build() *dst.ChanType {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.ChanType. It implements ExprBuilder.
+func (b *ChanType) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ChanType) WithComment(comments ...string) *ChanType {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ChanType) WithEndComment(comments ...string) *ChanType {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *ChanType) NewLineBefore() *ChanType {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *ChanType) EmptyLineBefore() *ChanType {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *ChanType) NewLineAfter() *ChanType {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *ChanType) EmptyLineAfter() *ChanType {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateBegin appends decorations to the Begin decorations.
+func (b *ChanType) DecorateBegin(decs ...string) *ChanType {
+	b.Node.Decs.Begin.Append(decs...)
+	return b
+}
+
+// DecorateArrow appends decorations to the Arrow decorations.
+func (b *ChanType) DecorateArrow(decs ...string) *ChanType {
+	b.Node.Decs.Arrow.Append(decs...)
+	return b
+}
+
+// CommClause holds a *dst.CommClause with chainable methods to set decorations. Use Build to get the
+// *dst.CommClause.
+type CommClause struct {
+	Node *dst.CommClause
+}
+
+// Build returns the *dst.CommClause.
+func (b *CommClause) Build() *dst.CommClause {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.CommClause. It implements StmtBuilder.
+func (b *CommClause) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *CommClause) WithComment(comments ...string) *CommClause {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *CommClause) WithEndComment(comments ...string) *CommClause {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *CommClause) NewLineBefore() *CommClause {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *CommClause) EmptyLineBefore() *CommClause {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *CommClause) NewLineAfter() *CommClause {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *CommClause) EmptyLineAfter() *CommClause {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateCase appends decorations to the Case decorations.
+func (b *CommClause) DecorateCase(decs ...string) *CommClause {
+	b.Node.Decs.Case.Append(decs...)
+	return b
+}
+
+// DecorateComm appends decorations to the Comm decorations.
+func (b *CommClause) DecorateComm(decs ...string) *CommClause {
+	b.Node.Decs.Comm.Append(decs...)
+	return b
+}
+
+// DecorateColon appends decorations to the Colon decorations.
+func (b *CommClause) DecorateColon(decs ...string) *CommClause {
+	b.Node.Decs.Colon.Append(decs...)
+	return b
+}
+
+// CommentDecl holds a *dst.CommentDecl with chainable methods to set decorations. Use Build to get the
+// *dst.CommentDecl.
+type CommentDecl struct {
+	Node *dst.CommentDecl
+}
+
+// Build returns the *dst.CommentDecl.
+func (b *CommentDecl) Build() *dst.CommentDecl {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *CommentDecl) WithComment(comments ...string) *CommentDecl {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *CommentDecl) WithEndComment(comments ...string) *CommentDecl {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *CommentDecl) NewLineBefore() *CommentDecl {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *CommentDecl) EmptyLineBefore() *CommentDecl {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *CommentDecl) NewLineAfter() *CommentDecl {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *CommentDecl) EmptyLineAfter() *CommentDecl {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// CommentStmt holds a *dst.CommentStmt with chainable methods to set decorations. Use Build to get the
+// *dst.CommentStmt.
+type CommentStmt struct {
+	Node *dst.CommentStmt
+}
+
+// Build returns the *dst.CommentStmt.
+func (b *CommentStmt) Build() *dst.CommentStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.CommentStmt. It implements StmtBuilder.
+func (b *CommentStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *CommentStmt) WithComment(comments ...string) *CommentStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *CommentStmt) WithEndComment(comments ...string) *CommentStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *CommentStmt) NewLineBefore() *CommentStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *CommentStmt) EmptyLineBefore() *CommentStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *CommentStmt) NewLineAfter() *CommentStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *CommentStmt) EmptyLineAfter() *CommentStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// CompositeLit holds a *dst.CompositeLit with chainable methods to set decorations. Use Build to get the
+// *dst.CompositeLit.
+type CompositeLit struct {
+	Node *dst.CompositeLit
+}
+
+// Build returns the *dst.CompositeLit.
+func (b *CompositeLit) Build() *dst.CompositeLit {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.CompositeLit. It implements ExprBuilder.
+func (b *CompositeLit) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *CompositeLit) WithComment(comments ...string) *CompositeLit {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *CompositeLit) WithEndComment(comments ...string) *CompositeLit {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *CompositeLit) NewLineBefore() *CompositeLit {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *CompositeLit) EmptyLineBefore() *CompositeLit {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *CompositeLit) NewLineAfter() *CompositeLit {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *CompositeLit) EmptyLineAfter() *CompositeLit {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateType appends decorations to the Type decorations.
+func (b *CompositeLit) DecorateType(decs ...string) *CompositeLit {
+	b.Node.Decs.Type.Append(decs...)
+	return b
+}
+
+// DecorateLbrace appends decorations to the Lbrace decorations.
+func (b *CompositeLit) DecorateLbrace(decs ...string) *CompositeLit {
+	b.Node.Decs.Lbrace.Append(decs...)
+	return b
+}
+
+// DeclStmt holds a *dst.DeclStmt with chainable methods to set decorations. Use Build to get the
+// *dst.DeclStmt.
+type DeclStmt struct {
+	Node *dst.DeclStmt
+}
+
+// Build returns the *dst.DeclStmt.
+func (b *DeclStmt) Build() *dst.DeclStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.DeclStmt. It implements StmtBuilder.
+func (b *DeclStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *DeclStmt) WithComment(comments ...string) *DeclStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *DeclStmt) WithEndComment(comments ...string) *DeclStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *DeclStmt) NewLineBefore() *DeclStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *DeclStmt) EmptyLineBefore() *DeclStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *DeclStmt) NewLineAfter() *DeclStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *DeclStmt) EmptyLineAfter() *DeclStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DeferStmt holds a *dst.DeferStmt with chainable methods to set decorations. Use Build to get the
+// *dst.DeferStmt.
+type DeferStmt struct {
+	Node *dst.DeferStmt
+}
+
+// Build returns the *dst.DeferStmt.
+func (b *DeferStmt) Build() *dst.DeferStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.DeferStmt. It implements StmtBuilder.
+func (b *DeferStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *DeferStmt) WithComment(comments ...string) *DeferStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *DeferStmt) WithEndComment(comments ...string) *DeferStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *DeferStmt) NewLineBefore() *DeferStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *DeferStmt) EmptyLineBefore() *DeferStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *DeferStmt) NewLineAfter() *DeferStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *DeferStmt) EmptyLineAfter() *DeferStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateDefer appends decorations to the Defer decorations.
+func (b *DeferStmt) DecorateDefer(decs ...string) *DeferStmt {
+	b.Node.Decs.Defer.Append(decs...)
+	return b
+}
+
+// Ellipsis holds a *dst.Ellipsis with chainable methods to set decorations. Use Build to get the
+// *dst.Ellipsis.
+type Ellipsis struct {
+	Node *dst.Ellipsis
+}
+
+// Build returns the *dst.Ellipsis.
+func (b *Ellipsis) Build() *dst.Ellipsis {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.Ellipsis. It implements ExprBuilder.
+func (b *Ellipsis) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *Ellipsis) WithComment(comments ...string) *Ellipsis {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *Ellipsis) WithEndComment(comments ...string) *Ellipsis {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *Ellipsis) NewLineBefore() *Ellipsis {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *Ellipsis) EmptyLineBefore() *Ellipsis {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *Ellipsis) NewLineAfter() *Ellipsis {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *Ellipsis) EmptyLineAfter() *Ellipsis {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateEllipsis appends decorations to the Ellipsis decorations.
+func (b *Ellipsis) DecorateEllipsis(decs ...string) *Ellipsis {
+	b.Node.Decs.Ellipsis.Append(decs...)
+	return b
+}
+
+// EmptyStmt holds a *dst.EmptyStmt with chainable methods to set decorations. Use Build to get the
+// *dst.EmptyStmt.
+type EmptyStmt struct {
+	Node *dst.EmptyStmt
+}
+
+// Build returns the *dst.EmptyStmt.
+func (b *EmptyStmt) Build() *dst.EmptyStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.EmptyStmt. It implements StmtBuilder.
+func (b *EmptyStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *EmptyStmt) WithComment(comments ...string) *EmptyStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *EmptyStmt) WithEndComment(comments ...string) *EmptyStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *EmptyStmt) NewLineBefore() *EmptyStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *EmptyStmt) EmptyLineBefore() *EmptyStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *EmptyStmt) NewLineAfter() *EmptyStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *EmptyStmt) EmptyLineAfter() *EmptyStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// ExprStmt holds a *dst.ExprStmt with chainable methods to set decorations. Use Build to get the
+// *dst.ExprStmt.
+type ExprStmt struct {
+	Node *dst.ExprStmt
+}
+
+// Build returns the *dst.ExprStmt.
+func (b *ExprStmt) Build() *dst.ExprStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.ExprStmt. It implements StmtBuilder.
+func (b *ExprStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ExprStmt) WithComment(comments ...string) *ExprStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ExprStmt) WithEndComment(comments ...string) *ExprStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *ExprStmt) NewLineBefore() *ExprStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *ExprStmt) EmptyLineBefore() *ExprStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *ExprStmt) NewLineAfter() *ExprStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *ExprStmt) EmptyLineAfter() *ExprStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// Field holds a *dst.Field with chainable methods to set decorations. Use Build to get the
+// *dst.Field.
+type Field struct {
+	Node *dst.Field
+}
+
+// Build returns the *dst.Field.
+func (b *Field) Build() *dst.Field {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *Field) WithComment(comments ...string) *Field {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *Field) WithEndComment(comments ...string) *Field {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *Field) NewLineBefore() *Field {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *Field) EmptyLineBefore() *Field {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *Field) NewLineAfter() *Field {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *Field) EmptyLineAfter() *Field {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateType appends decorations to the Type decorations.
+func (b *Field) DecorateType(decs ...string) *Field {
+	b.Node.Decs.Type.Append(decs...)
+	return b
+}
+
+// FieldList holds a *dst.FieldList with chainable methods to set decorations. Use Build to get the
+// *dst.FieldList.
+type FieldList struct {
+	Node *dst.FieldList
+}
+
+// Build returns the *dst.FieldList.
+func (b *FieldList) Build() *dst.FieldList {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *FieldList) WithComment(comments ...string) *FieldList {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *FieldList) WithEndComment(comments ...string) *FieldList {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *FieldList) NewLineBefore() *FieldList {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *FieldList) EmptyLineBefore() *FieldList {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *FieldList) NewLineAfter() *FieldList {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *FieldList) EmptyLineAfter() *FieldList {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateOpening appends decorations to the Opening decorations.
+func (b *FieldList) DecorateOpening(decs ...string) *FieldList {
+	b.Node.Decs.Opening.Append(decs...)
+	return b
+}
+
+// File holds a *dst.File with chainable methods to set decorations. Use Build to get the
+// *dst.File.
+type File struct {
+	Node *dst.File
+}
+
+// Build returns the *dst.File.
+func (b *File) Build() *dst.File {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *File) WithComment(comments ...string) *File {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *File) WithEndComment(comments ...string) *File {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *File) NewLineBefore() *File {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *File) EmptyLineBefore() *File {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *File) NewLineAfter() *File {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *File) EmptyLineAfter() *File {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecoratePackage appends decorations to the Package decorations.
+func (b *File) DecoratePackage(decs ...string) *File {
+	b.Node.Decs.Package.Append(decs...)
+	return b
+}
+
+// DecorateName appends decorations to the Name decorations.
+func (b *File) DecorateName(decs ...string) *File {
+	b.Node.Decs.Name.Append(decs...)
+	return b
+}
+
+// ForStmt holds a *dst.ForStmt with chainable methods to set decorations. Use Build to get the
+// *dst.ForStmt.
+type ForStmt struct {
+	Node *dst.ForStmt
+}
+
+// Build returns the *dst.ForStmt.
+func (b *ForStmt) Build() *dst.ForStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.ForStmt. It implements StmtBuilder.
+func (b *ForStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ForStmt) WithComment(comments ...string) *ForStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ForStmt) WithEndComment(comments ...string) *ForStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *ForStmt) NewLineBefore() *ForStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *ForStmt) EmptyLineBefore() *ForStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *ForStmt) NewLineAfter() *ForStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *ForStmt) EmptyLineAfter() *ForStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateFor appends decorations to the For decorations.
+func (b *ForStmt) DecorateFor(decs ...string) *ForStmt {
+	b.Node.Decs.For.Append(decs...)
+	return b
+}
+
+// DecorateInit appends decorations to the Init decorations.
+func (b *ForStmt) DecorateInit(decs ...string) *ForStmt {
+	b.Node.Decs.Init.Append(decs...)
+	return b
+}
+
+// DecorateCond appends decorations to the Cond decorations.
+func (b *ForStmt) DecorateCond(decs ...string) *ForStmt {
+	b.Node.Decs.Cond.Append(decs...)
+	return b
+}
+
+// DecoratePost appends decorations to the Post decorations.
+func (b *ForStmt) DecoratePost(decs ...string) *ForStmt {
+	b.Node.Decs.Post.Append(decs...)
+	return b
+}
+
+// FuncDecl holds a *dst.FuncDecl with chainable methods to set decorations. Use Build to get the
+// *dst.FuncDecl.
+type FuncDecl struct {
+	Node *dst.FuncDecl
+}
+
+// Build returns the *dst.FuncDecl.
+func (b *FuncDecl) Build() *dst.FuncDecl {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *FuncDecl) WithComment(comments ...string) *FuncDecl {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *FuncDecl) WithEndComment(comments ...string) *FuncDecl {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *FuncDecl) NewLineBefore() *FuncDecl {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *FuncDecl) EmptyLineBefore() *FuncDecl {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *FuncDecl) NewLineAfter() *FuncDecl {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *FuncDecl) EmptyLineAfter() *FuncDecl {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateFunc appends decorations to the Func decorations.
+func (b *FuncDecl) DecorateFunc(decs ...string) *FuncDecl {
+	b.Node.Decs.Func.Append(decs...)
+	return b
+}
+
+// DecorateRecv appends decorations to the Recv decorations.
+func (b *FuncDecl) DecorateRecv(decs ...string) *FuncDecl {
+	b.Node.Decs.Recv.Append(decs...)
+	return b
+}
+
+// DecorateName appends decorations to the Name decorations.
+func (b *FuncDecl) DecorateName(decs ...string) *FuncDecl {
+	b.Node.Decs.Name.Append(decs...)
+	return b
+}
+
+// DecorateTypeParams appends decorations to the TypeParams decorations.
+func (b *FuncDecl) DecorateTypeParams(decs ...string) *FuncDecl {
+	b.Node.Decs.TypeParams.Append(decs...)
+	return b
+}
+
+// DecorateParams appends decorations to the Params decorations.
+func (b *FuncDecl) DecorateParams(decs ...string) *FuncDecl {
+	b.Node.Decs.Params.Append(decs...)
+	return b
+}
+
+// DecorateResults appends decorations to the Results decorations.
+func (b *FuncDecl) DecorateResults(decs ...string) *FuncDecl {
+	b.Node.Decs.Results.Append(decs...)
+	return b
+}
+
+// FuncLit holds a *dst.FuncLit with chainable methods to set decorations. Use Build to get the
+// *dst.FuncLit.
+type FuncLit struct {
+	Node *dst.FuncLit
+}
+
+// Build returns the *dst.FuncLit.
+func (b *FuncLit) Build() *dst.FuncLit {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.FuncLit. It implements ExprBuilder.
+func (b *FuncLit) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *FuncLit) WithComment(comments ...string) *FuncLit {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *FuncLit) WithEndComment(comments ...string) *FuncLit {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *FuncLit) NewLineBefore() *FuncLit {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *FuncLit) EmptyLineBefore() *FuncLit {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *FuncLit) NewLineAfter() *FuncLit {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *FuncLit) EmptyLineAfter() *FuncLit {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateType appends decorations to the Type decorations.
+func (b *FuncLit) DecorateType(decs ...string) *FuncLit {
+	b.Node.Decs.Type.Append(decs...)
+	return b
+}
+
+// FuncType holds a *dst.FuncType with chainable methods to set decorations. Use Build to get the
+// *dst.FuncType.
+type FuncType struct {
+	Node *dst.FuncType
+}
+
+// Build returns the *dst.FuncType.
+func (b *FuncType) Build() *dst.FuncType {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.FuncType. It implements ExprBuilder.
+func (b *FuncType) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *FuncType) WithComment(comments ...string) *FuncType {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *FuncType) WithEndComment(comments ...string) *FuncType {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *FuncType) NewLineBefore() *FuncType {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *FuncType) EmptyLineBefore() *FuncType {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *FuncType) NewLineAfter() *FuncType {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *FuncType) EmptyLineAfter() *FuncType {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateFunc appends decorations to the Func decorations.
+func (b *FuncType) DecorateFunc(decs ...string) *FuncType {
+	b.Node.Decs.Func.Append(decs...)
+	return b
+}
+
+// DecorateTypeParams appends decorations to the TypeParams decorations.
+func (b *FuncType) DecorateTypeParams(decs ...string) *FuncType {
+	b.Node.Decs.TypeParams.Append(decs...)
+	return b
+}
+
+// DecorateParams appends decorations to the Params decorations.
+func (b *FuncType) DecorateParams(decs ...string) *FuncType {
+	b.Node.Decs.Params.Append(decs...)
+	return b
+}
+
+// GenDecl holds a *dst.GenDecl with chainable methods to set decorations. Use Build to get the
+// *dst.GenDecl.
+type GenDecl struct {
+	Node *dst.GenDecl
+}
+
+// Build returns the *dst.GenDecl.
+func (b *GenDecl) Build() *dst.GenDecl {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *GenDecl) WithComment(comments ...string) *GenDecl {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *GenDecl) WithEndComment(comments ...string) *GenDecl {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *GenDecl) NewLineBefore() *GenDecl {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *GenDecl) EmptyLineBefore() *GenDecl {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *GenDecl) NewLineAfter() *GenDecl {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *GenDecl) EmptyLineAfter() *GenDecl {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateTok appends decorations to the Tok decorations.
+func (b *GenDecl) DecorateTok(decs ...string) *GenDecl {
+	b.Node.Decs.Tok.Append(decs...)
+	return b
+}
+
+// DecorateLparen appends decorations to the Lparen decorations.
+func (b *GenDecl) DecorateLparen(decs ...string) *GenDecl {
+	b.Node.Decs.Lparen.Append(decs...)
+	return b
+}
+
+// GoStmt holds a *dst.GoStmt with chainable methods to set decorations. Use Build to get the
+// *dst.GoStmt.
+type GoStmt struct {
+	Node *dst.GoStmt
+}
+
+// Build returns the *dst.GoStmt.
+func (b *GoStmt) Build() *dst.GoStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.GoStmt. It implements StmtBuilder.
+func (b *GoStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *GoStmt) WithComment(comments ...string) *GoStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *GoStmt) WithEndComment(comments ...string) *GoStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *GoStmt) NewLineBefore() *GoStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *GoStmt) EmptyLineBefore() *GoStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *GoStmt) NewLineAfter() *GoStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *GoStmt) EmptyLineAfter() *GoStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateGo appends decorations to the Go decorations.
+func (b *GoStmt) DecorateGo(decs ...string) *GoStmt {
+	b.Node.Decs.Go.Append(decs...)
+	return b
+}
+
+// Ident holds a *dst.Ident with chainable methods to set decorations. Use Build to get the
+// *dst.Ident.
+type Ident struct {
+	Node *dst.Ident
+}
+
+// Build returns the *dst.Ident.
+func (b *Ident) Build() *dst.Ident {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.Ident. It implements ExprBuilder.
+func (b *Ident) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *Ident) WithComment(comments ...string) *Ident {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *Ident) WithEndComment(comments ...string) *Ident {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *Ident) NewLineBefore() *Ident {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *Ident) EmptyLineBefore() *Ident {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *Ident) NewLineAfter() *Ident {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *Ident) EmptyLineAfter() *Ident {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateX appends decorations to the X decorations.
+func (b *Ident) DecorateX(decs ...string) *Ident {
+	b.Node.Decs.X.Append(decs...)
+	return b
+}
+
+// IfStmt holds a *dst.IfStmt with chainable methods to set decorations. Use Build to get the
+// *dst.IfStmt.
+type IfStmt struct {
+	Node *dst.IfStmt
+}
+
+// Build returns the *dst.IfStmt.
+func (b *IfStmt) Build() *dst.IfStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.IfStmt. It implements StmtBuilder.
+func (b *IfStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *IfStmt) WithComment(comments ...string) *IfStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *IfStmt) WithEndComment(comments ...string) *IfStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *IfStmt) NewLineBefore() *IfStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *IfStmt) EmptyLineBefore() *IfStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *IfStmt) NewLineAfter() *IfStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *IfStmt) EmptyLineAfter() *IfStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateIf appends decorations to the If decorations.
+func (b *IfStmt) DecorateIf(decs ...string) *IfStmt {
+	b.Node.Decs.If.Append(decs...)
+	return b
+}
+
+// DecorateInit appends decorations to the Init decorations.
+func (b *IfStmt) DecorateInit(decs ...string) *IfStmt {
+	b.Node.Decs.Init.Append(decs...)
+	return b
+}
+
+// DecorateCond appends decorations to the Cond decorations.
+func (b *IfStmt) DecorateCond(decs ...string) *IfStmt {
+	b.Node.Decs.Cond.Append(decs...)
+	return b
+}
+
+// DecorateElse appends decorations to the Else decorations.
+func (b *IfStmt) DecorateElse(decs ...string) *IfStmt {
+	b.Node.Decs.Else.Append(decs...)
+	return b
+}
+
+// ImportSpec holds a *dst.ImportSpec with chainable methods to set decorations. Use Build to get the
+// *dst.ImportSpec.
+type ImportSpec struct {
+	Node *dst.ImportSpec
+}
+
+// Build returns the *dst.ImportSpec.
+func (b *ImportSpec) Build() *dst.ImportSpec {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ImportSpec) WithComment(comments ...string) *ImportSpec {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ImportSpec) WithEndComment(comments ...string) *ImportSpec {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *ImportSpec) NewLineBefore() *ImportSpec {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *ImportSpec) EmptyLineBefore() *ImportSpec {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *ImportSpec) NewLineAfter() *ImportSpec {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *ImportSpec) EmptyLineAfter() *ImportSpec {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateName appends decorations to the Name decorations.
+func (b *ImportSpec) DecorateName(decs ...string) *ImportSpec {
+	b.Node.Decs.Name.Append(decs...)
+	return b
+}
+
+// IncDecStmt holds a *dst.IncDecStmt with chainable methods to set decorations. Use Build to get the
+// *dst.IncDecStmt.
+type IncDecStmt struct {
+	Node *dst.IncDecStmt
+}
+
+// Build returns the *dst.IncDecStmt.
+func (b *IncDecStmt) Build() *dst.IncDecStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.IncDecStmt. It implements StmtBuilder.
+func (b *IncDecStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *IncDecStmt) WithComment(comments ...string) *IncDecStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *IncDecStmt) WithEndComment(comments ...string) *IncDecStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *IncDecStmt) NewLineBefore() *IncDecStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *IncDecStmt) EmptyLineBefore() *IncDecStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *IncDecStmt) NewLineAfter() *IncDecStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *IncDecStmt) EmptyLineAfter() *IncDecStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateX appends decorations to the X decorations.
+func (b *IncDecStmt) DecorateX(decs ...string) *IncDecStmt {
+	b.Node.Decs.X.Append(decs...)
+	return b
+}
+
+// IndexExpr holds a *dst.IndexExpr with chainable methods to set decorations. Use Build to get the
+// *dst.IndexExpr.
+type IndexExpr struct {
+	Node *dst.IndexExpr
+}
+
+// Build returns the *dst.IndexExpr.
+func (b *IndexExpr) Build() *dst.IndexExpr {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.IndexExpr. It implements ExprBuilder.
+func (b *IndexExpr) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *IndexExpr) WithComment(comments ...string) *IndexExpr {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *IndexExpr) WithEndComment(comments ...string) *IndexExpr {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *IndexExpr) NewLineBefore() *IndexExpr {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *IndexExpr) EmptyLineBefore() *IndexExpr {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *IndexExpr) NewLineAfter() *IndexExpr {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *IndexExpr) EmptyLineAfter() *IndexExpr {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateX appends decorations to the X decorations.
+func (b *IndexExpr) DecorateX(decs ...string) *IndexExpr {
+	b.Node.Decs.X.Append(decs...)
+	return b
+}
+
+// DecorateLbrack appends decorations to the Lbrack decorations.
+func (b *IndexExpr) DecorateLbrack(decs ...string) *IndexExpr {
+	b.Node.Decs.Lbrack.Append(decs...)
+	return b
+}
+
+// DecorateIndex appends decorations to the Index decorations.
+func (b *IndexExpr) DecorateIndex(decs ...string) *IndexExpr {
+	b.Node.Decs.Index.Append(decs...)
+	return b
+}
+
+// IndexListExpr holds a *dst.IndexListExpr with chainable methods to set decorations. Use Build to get the
+// *dst.IndexListExpr.
+type IndexListExpr struct {
+	Node *dst.IndexListExpr
+}
+
+// Build returns the *dst.IndexListExpr.
+func (b *IndexListExpr) Build() *dst.IndexListExpr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *IndexListExpr) WithComment(comments ...string) *IndexListExpr {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *IndexListExpr) WithEndComment(comments ...string) *IndexListExpr {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *IndexListExpr) NewLineBefore() *IndexListExpr {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *IndexListExpr) EmptyLineBefore() *IndexListExpr {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *IndexListExpr) NewLineAfter() *IndexListExpr {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *IndexListExpr) EmptyLineAfter() *IndexListExpr {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateX appends decorations to the X decorations.
+func (b *IndexListExpr) DecorateX(decs ...string) *IndexListExpr {
+	b.Node.Decs.X.Append(decs...)
+	return b
+}
+
+// DecorateLbrack appends decorations to the Lbrack decorations.
+func (b *IndexListExpr) DecorateLbrack(decs ...string) *IndexListExpr {
+	b.Node.Decs.Lbrack.Append(decs...)
+	return b
+}
+
+// DecorateIndices appends decorations to the Indices decorations.
+func (b *IndexListExpr) DecorateIndices(decs ...string) *IndexListExpr {
+	b.Node.Decs.Indices.Append(decs...)
+	return b
+}
+
+// InterfaceType holds a *dst.InterfaceType with chainable methods to set decorations. Use Build to get the
+// *dst.InterfaceType.
+type InterfaceType struct {
+	Node *dst.InterfaceType
+}
+
+// Build returns the *dst.InterfaceType.
+func (b *InterfaceType) Build() *dst.InterfaceType {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.InterfaceType. It implements ExprBuilder.
+func (b *InterfaceType) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *InterfaceType) WithComment(comments ...string) *InterfaceType {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *InterfaceType) WithEndComment(comments ...string) *InterfaceType {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *InterfaceType) NewLineBefore() *InterfaceType {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *InterfaceType) EmptyLineBefore() *InterfaceType {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *InterfaceType) NewLineAfter() *InterfaceType {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *InterfaceType) EmptyLineAfter() *InterfaceType {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateInterface appends decorations to the Interface decorations.
+func (b *InterfaceType) DecorateInterface(decs ...string) *InterfaceType {
+	b.Node.Decs.Interface.Append(decs...)
+	return b
+}
+
+// KeyValueExpr holds a *dst.KeyValueExpr with chainable methods to set decorations. Use Build to get the
+// *dst.KeyValueExpr.
+type KeyValueExpr struct {
+	Node *dst.KeyValueExpr
+}
+
+// Build returns the *dst.KeyValueExpr.
+func (b *KeyValueExpr) Build() *dst.KeyValueExpr {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.KeyValueExpr. It implements ExprBuilder.
+func (b *KeyValueExpr) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *KeyValueExpr) WithComment(comments ...string) *KeyValueExpr {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *KeyValueExpr) WithEndComment(comments ...string) *KeyValueExpr {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *KeyValueExpr) NewLineBefore() *KeyValueExpr {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *KeyValueExpr) EmptyLineBefore() *KeyValueExpr {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *KeyValueExpr) NewLineAfter() *KeyValueExpr {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *KeyValueExpr) EmptyLineAfter() *KeyValueExpr {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateKey appends decorations to the Key decorations.
+func (b *KeyValueExpr) DecorateKey(decs ...string) *KeyValueExpr {
+	b.Node.Decs.Key.Append(decs...)
+	return b
+}
+
+// DecorateColon appends decorations to the Colon decorations.
+func (b *KeyValueExpr) DecorateColon(decs ...string) *KeyValueExpr {
+	b.Node.Decs.Colon.Append(decs...)
+	return b
+}
+
+// LabeledStmt holds a *dst.LabeledStmt with chainable methods to set decorations. Use Build to get the
+// *dst.LabeledStmt.
+type LabeledStmt struct {
+	Node *dst.LabeledStmt
+}
+
+// Build returns the *dst.LabeledStmt.
+func (b *LabeledStmt) Build() *dst.LabeledStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.LabeledStmt. It implements StmtBuilder.
+func (b *LabeledStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *LabeledStmt) WithComment(comments ...string) *LabeledStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *LabeledStmt) WithEndComment(comments ...string) *LabeledStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *LabeledStmt) NewLineBefore() *LabeledStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *LabeledStmt) EmptyLineBefore() *LabeledStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *LabeledStmt) NewLineAfter() *LabeledStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *LabeledStmt) EmptyLineAfter() *LabeledStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateLabel appends decorations to the Label decorations.
+func (b *LabeledStmt) DecorateLabel(decs ...string) *LabeledStmt {
+	b.Node.Decs.Label.Append(decs...)
+	return b
+}
+
+// DecorateColon appends decorations to the Colon decorations.
+func (b *LabeledStmt) DecorateColon(decs ...string) *LabeledStmt {
+	b.Node.Decs.Colon.Append(decs...)
+	return b
+}
+
+// MapType holds a *dst.MapType with chainable methods to set decorations. Use Build to get the
+// *dst.MapType.
+type MapType struct {
+	Node *dst.MapType
+}
+
+// Build returns the *dst.MapType.
+func (b *MapType) Build() *dst.MapType {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.MapType. It implements ExprBuilder.
+func (b *MapType) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *MapType) WithComment(comments ...string) *MapType {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *MapType) WithEndComment(comments ...string) *MapType {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *MapType) NewLineBefore() *MapType {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *MapType) EmptyLineBefore() *MapType {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *MapType) NewLineAfter() *MapType {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *MapType) EmptyLineAfter() *MapType {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateMap appends decorations to the Map decorations.
+func (b *MapType) DecorateMap(decs ...string) *MapType {
+	b.Node.Decs.Map.Append(decs...)
+	return b
+}
+
+// DecorateKey appends decorations to the Key decorations.
+func (b *MapType) DecorateKey(decs ...string) *MapType {
+	b.Node.Decs.Key.Append(decs...)
+	return b
+}
+
+// ParenExpr holds a *dst.ParenExpr with chainable methods to set decorations. Use Build to get the
+// *dst.ParenExpr.
+type ParenExpr struct {
+	Node *dst.ParenExpr
+}
+
+// Build returns the *dst.ParenExpr.
+func (b *ParenExpr) Build() *dst.ParenExpr {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.ParenExpr. It implements ExprBuilder.
+func (b *ParenExpr) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ParenExpr) WithComment(comments ...string) *ParenExpr {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ParenExpr) WithEndComment(comments ...string) *ParenExpr {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *ParenExpr) NewLineBefore() *ParenExpr {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *ParenExpr) EmptyLineBefore() *ParenExpr {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *ParenExpr) NewLineAfter() *ParenExpr {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *ParenExpr) EmptyLineAfter() *ParenExpr {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateLparen appends decorations to the Lparen decorations.
+func (b *ParenExpr) DecorateLparen(decs ...string) *ParenExpr {
+	b.Node.Decs.Lparen.Append(decs...)
+	return b
+}
+
+// DecorateX appends decorations to the X decorations.
+func (b *ParenExpr) DecorateX(decs ...string) *ParenExpr {
+	b.Node.Decs.X.Append(decs...)
+	return b
+}
+
+// RangeStmt holds a *dst.RangeStmt with chainable methods to set decorations. Use Build to get the
+// *dst.RangeStmt.
+type RangeStmt struct {
+	Node *dst.RangeStmt
+}
+
+// Build returns the *dst.RangeStmt.
+func (b *RangeStmt) Build() *dst.RangeStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.RangeStmt. It implements StmtBuilder.
+func (b *RangeStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *RangeStmt) WithComment(comments ...string) *RangeStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *RangeStmt) WithEndComment(comments ...string) *RangeStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *RangeStmt) NewLineBefore() *RangeStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *RangeStmt) EmptyLineBefore() *RangeStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *RangeStmt) NewLineAfter() *RangeStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *RangeStmt) EmptyLineAfter() *RangeStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateFor appends decorations to the For decorations.
+func (b *RangeStmt) DecorateFor(decs ...string) *RangeStmt {
+	b.Node.Decs.For.Append(decs...)
+	return b
+}
+
+// DecorateKey appends decorations to the Key decorations.
+func (b *RangeStmt) DecorateKey(decs ...string) *RangeStmt {
+	b.Node.Decs.Key.Append(decs...)
+	return b
+}
+
+// DecorateValue appends decorations to the Value decorations.
+func (b *RangeStmt) DecorateValue(decs ...string) *RangeStmt {
+	b.Node.Decs.Value.Append(decs...)
+	return b
+}
+
+// DecorateRange appends decorations to the Range decorations.
+func (b *RangeStmt) DecorateRange(decs ...string) *RangeStmt {
+	b.Node.Decs.Range.Append(decs...)
+	return b
+}
+
+// DecorateX appends decorations to the X decorations.
+func (b *RangeStmt) DecorateX(decs ...string) *RangeStmt {
+	b.Node.Decs.X.Append(decs...)
+	return b
+}
+
+// ReturnStmt holds a *dst.ReturnStmt with chainable methods to set decorations. Use Build to get the
+// *dst.ReturnStmt.
+type ReturnStmt struct {
+	Node *dst.ReturnStmt
+}
+
+// Build returns the *dst.ReturnStmt.
+func (b *ReturnStmt) Build() *dst.ReturnStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.ReturnStmt. It implements StmtBuilder.
+func (b *ReturnStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ReturnStmt) WithComment(comments ...string) *ReturnStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ReturnStmt) WithEndComment(comments ...string) *ReturnStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *ReturnStmt) NewLineBefore() *ReturnStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *ReturnStmt) EmptyLineBefore() *ReturnStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *ReturnStmt) NewLineAfter() *ReturnStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *ReturnStmt) EmptyLineAfter() *ReturnStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateReturn appends decorations to the Return decorations.
+func (b *ReturnStmt) DecorateReturn(decs ...string) *ReturnStmt {
+	b.Node.Decs.Return.Append(decs...)
+	return b
+}
+
+// SelectStmt holds a *dst.SelectStmt with chainable methods to set decorations. Use Build to get the
+// *dst.SelectStmt.
+type SelectStmt struct {
+	Node *dst.SelectStmt
+}
+
+// Build returns the *dst.SelectStmt.
+func (b *SelectStmt) Build() *dst.SelectStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.SelectStmt. It implements StmtBuilder.
+func (b *SelectStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *SelectStmt) WithComment(comments ...string) *SelectStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *SelectStmt) WithEndComment(comments ...string) *SelectStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *SelectStmt) NewLineBefore() *SelectStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *SelectStmt) EmptyLineBefore() *SelectStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *SelectStmt) NewLineAfter() *SelectStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *SelectStmt) EmptyLineAfter() *SelectStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateSelect appends decorations to the Select decorations.
+func (b *SelectStmt) DecorateSelect(decs ...string) *SelectStmt {
+	b.Node.Decs.Select.Append(decs...)
+	return b
+}
+
+// SelectorExpr holds a *dst.SelectorExpr with chainable methods to set decorations. Use Build to get the
+// *dst.SelectorExpr.
+type SelectorExpr struct {
+	Node *dst.SelectorExpr
+}
+
+// Build returns the *dst.SelectorExpr.
+func (b *SelectorExpr) Build() *dst.SelectorExpr {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.SelectorExpr. It implements ExprBuilder.
+func (b *SelectorExpr) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *SelectorExpr) WithComment(comments ...string) *SelectorExpr {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *SelectorExpr) WithEndComment(comments ...string) *SelectorExpr {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *SelectorExpr) NewLineBefore() *SelectorExpr {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *SelectorExpr) EmptyLineBefore() *SelectorExpr {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *SelectorExpr) NewLineAfter() *SelectorExpr {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *SelectorExpr) EmptyLineAfter() *SelectorExpr {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateX appends decorations to the X decorations.
+func (b *SelectorExpr) DecorateX(decs ...string) *SelectorExpr {
+	b.Node.Decs.X.Append(decs...)
+	return b
+}
+
+// SendStmt holds a *dst.SendStmt with chainable methods to set decorations. Use Build to get the
+// *dst.SendStmt.
+type SendStmt struct {
+	Node *dst.SendStmt
+}
+
+// Build returns the *dst.SendStmt.
+func (b *SendStmt) Build() *dst.SendStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.SendStmt. It implements StmtBuilder.
+func (b *SendStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *SendStmt) WithComment(comments ...string) *SendStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *SendStmt) WithEndComment(comments ...string) *SendStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *SendStmt) NewLineBefore() *SendStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *SendStmt) EmptyLineBefore() *SendStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *SendStmt) NewLineAfter() *SendStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *SendStmt) EmptyLineAfter() *SendStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateChan appends decorations to the Chan decorations.
+func (b *SendStmt) DecorateChan(decs ...string) *SendStmt {
+	b.Node.Decs.Chan.Append(decs...)
+	return b
+}
+
+// DecorateArrow appends decorations to the Arrow decorations.
+func (b *SendStmt) DecorateArrow(decs ...string) *SendStmt {
+	b.Node.Decs.Arrow.Append(decs...)
+	return b
+}
+
+// SliceExpr holds a *dst.SliceExpr with chainable methods to set decorations. Use Build to get the
+// *dst.SliceExpr.
+type SliceExpr struct {
+	Node *dst.SliceExpr
+}
+
+// Build returns the *dst.SliceExpr.
+func (b *SliceExpr) Build() *dst.SliceExpr {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.SliceExpr. It implements ExprBuilder.
+func (b *SliceExpr) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *SliceExpr) WithComment(comments ...string) *SliceExpr {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *SliceExpr) WithEndComment(comments ...string) *SliceExpr {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *SliceExpr) NewLineBefore() *SliceExpr {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *SliceExpr) EmptyLineBefore() *SliceExpr {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *SliceExpr) NewLineAfter() *SliceExpr {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *SliceExpr) EmptyLineAfter() *SliceExpr {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateX appends decorations to the X decorations.
+func (b *SliceExpr) DecorateX(decs ...string) *SliceExpr {
+	b.Node.Decs.X.Append(decs...)
+	return b
+}
+
+// DecorateLbrack appends decorations to the Lbrack decorations.
+func (b *SliceExpr) DecorateLbrack(decs ...string) *SliceExpr {
+	b.Node.Decs.Lbrack.Append(decs...)
+	return b
+}
+
+// DecorateLow appends decorations to the Low decorations.
+func (b *SliceExpr) DecorateLow(decs ...string) *SliceExpr {
+	b.Node.Decs.Low.Append(decs...)
+	return b
+}
+
+// DecorateHigh appends decorations to the High decorations.
+func (b *SliceExpr) DecorateHigh(decs ...string) *SliceExpr {
+	b.Node.Decs.High.Append(decs...)
+	return b
+}
+
+// DecorateMax appends decorations to the Max decorations.
+func (b *SliceExpr) DecorateMax(decs ...string) *SliceExpr {
+	b.Node.Decs.Max.Append(decs...)
+	return b
+}
+
+// StarExpr holds a *dst.StarExpr with chainable methods to set decorations. Use Build to get the
+// *dst.StarExpr.
+type StarExpr struct {
+	Node *dst.StarExpr
+}
+
+// Build returns the *dst.StarExpr.
+func (b *StarExpr) Build() *dst.StarExpr {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.StarExpr. It implements ExprBuilder.
+func (b *StarExpr) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *StarExpr) WithComment(comments ...string) *StarExpr {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *StarExpr) WithEndComment(comments ...string) *StarExpr {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *StarExpr) NewLineBefore() *StarExpr {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *StarExpr) EmptyLineBefore() *StarExpr {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *StarExpr) NewLineAfter() *StarExpr {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *StarExpr) EmptyLineAfter() *StarExpr {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateStar appends decorations to the Star decorations.
+func (b *StarExpr) DecorateStar(decs ...string) *StarExpr {
+	b.Node.Decs.Star.Append(decs...)
+	return b
+}
+
+// StructType holds a *dst.StructType with chainable methods to set decorations. Use Build to get the
+// *dst.StructType.
+type StructType struct {
+	Node *dst.StructType
+}
+
+// Build returns the *dst.StructType.
+func (b *StructType) Build() *dst.StructType {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.StructType. It implements ExprBuilder.
+func (b *StructType) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *StructType) WithComment(comments ...string) *StructType {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *StructType) WithEndComment(comments ...string) *StructType {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *StructType) NewLineBefore() *StructType {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *StructType) EmptyLineBefore() *StructType {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *StructType) NewLineAfter() *StructType {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *StructType) EmptyLineAfter() *StructType {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateStruct appends decorations to the Struct decorations.
+func (b *StructType) DecorateStruct(decs ...string) *StructType {
+	b.Node.Decs.Struct.Append(decs...)
+	return b
+}
+
+// SwitchStmt holds a *dst.SwitchStmt with chainable methods to set decorations. Use Build to get the
+// *dst.SwitchStmt.
+type SwitchStmt struct {
+	Node *dst.SwitchStmt
+}
+
+// Build returns the *dst.SwitchStmt.
+func (b *SwitchStmt) Build() *dst.SwitchStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.SwitchStmt. It implements StmtBuilder.
+func (b *SwitchStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *SwitchStmt) WithComment(comments ...string) *SwitchStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *SwitchStmt) WithEndComment(comments ...string) *SwitchStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *SwitchStmt) NewLineBefore() *SwitchStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *SwitchStmt) EmptyLineBefore() *SwitchStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *SwitchStmt) NewLineAfter() *SwitchStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *SwitchStmt) EmptyLineAfter() *SwitchStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateSwitch appends decorations to the Switch decorations.
+func (b *SwitchStmt) DecorateSwitch(decs ...string) *SwitchStmt {
+	b.Node.Decs.Switch.Append(decs...)
+	return b
+}
+
+// DecorateInit appends decorations to the Init decorations.
+func (b *SwitchStmt) DecorateInit(decs ...string) *SwitchStmt {
+	b.Node.Decs.Init.Append(decs...)
+	return b
+}
+
+// DecorateTag appends decorations to the Tag decorations.
+func (b *SwitchStmt) DecorateTag(decs ...string) *SwitchStmt {
+	b.Node.Decs.Tag.Append(decs...)
+	return b
+}
+
+// TypeAssertExpr holds a *dst.TypeAssertExpr with chainable methods to set decorations. Use Build to get the
+// *dst.TypeAssertExpr.
+type TypeAssertExpr struct {
+	Node *dst.TypeAssertExpr
+}
+
+// Build returns the *dst.TypeAssertExpr.
+func (b *TypeAssertExpr) Build() *dst.TypeAssertExpr {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.TypeAssertExpr. It implements ExprBuilder.
+func (b *TypeAssertExpr) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *TypeAssertExpr) WithComment(comments ...string) *TypeAssertExpr {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *TypeAssertExpr) WithEndComment(comments ...string) *TypeAssertExpr {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *TypeAssertExpr) NewLineBefore() *TypeAssertExpr {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *TypeAssertExpr) EmptyLineBefore() *TypeAssertExpr {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *TypeAssertExpr) NewLineAfter() *TypeAssertExpr {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *TypeAssertExpr) EmptyLineAfter() *TypeAssertExpr {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateX appends decorations to the X decorations.
+func (b *TypeAssertExpr) DecorateX(decs ...string) *TypeAssertExpr {
+	b.Node.Decs.X.Append(decs...)
+	return b
+}
+
+// DecorateLparen appends decorations to the Lparen decorations.
+func (b *TypeAssertExpr) DecorateLparen(decs ...string) *TypeAssertExpr {
+	b.Node.Decs.Lparen.Append(decs...)
+	return b
+}
+
+// DecorateType appends decorations to the Type decorations.
+func (b *TypeAssertExpr) DecorateType(decs ...string) *TypeAssertExpr {
+	b.Node.Decs.Type.Append(decs...)
+	return b
+}
+
+// TypeSpec holds a *dst.TypeSpec with chainable methods to set decorations. Use Build to get the
+// *dst.TypeSpec.
+type TypeSpec struct {
+	Node *dst.TypeSpec
+}
+
+// Build returns the *dst.TypeSpec.
+func (b *TypeSpec) Build() *dst.TypeSpec {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *TypeSpec) WithComment(comments ...string) *TypeSpec {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *TypeSpec) WithEndComment(comments ...string) *TypeSpec {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *TypeSpec) NewLineBefore() *TypeSpec {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *TypeSpec) EmptyLineBefore() *TypeSpec {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *TypeSpec) NewLineAfter() *TypeSpec {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *TypeSpec) EmptyLineAfter() *TypeSpec {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateName appends decorations to the Name decorations.
+func (b *TypeSpec) DecorateName(decs ...string) *TypeSpec {
+	b.Node.Decs.Name.Append(decs...)
+	return b
+}
+
+// DecorateTypeParams appends decorations to the TypeParams decorations.
+func (b *TypeSpec) DecorateTypeParams(decs ...string) *TypeSpec {
+	b.Node.Decs.TypeParams.Append(decs...)
+	return b
+}
+
+// TypeSwitchStmt holds a *dst.TypeSwitchStmt with chainable methods to set decorations. Use Build to get the
+// *dst.TypeSwitchStmt.
+type TypeSwitchStmt struct {
+	Node *dst.TypeSwitchStmt
+}
+
+// Build returns the *dst.TypeSwitchStmt.
+func (b *TypeSwitchStmt) Build() *dst.TypeSwitchStmt {
+	return b.Node
+}
+
+// BuildStmt returns the *dst.TypeSwitchStmt. It implements StmtBuilder.
+func (b *TypeSwitchStmt) BuildStmt() dst.Stmt {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *TypeSwitchStmt) WithComment(comments ...string) *TypeSwitchStmt {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *TypeSwitchStmt) WithEndComment(comments ...string) *TypeSwitchStmt {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *TypeSwitchStmt) NewLineBefore() *TypeSwitchStmt {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *TypeSwitchStmt) EmptyLineBefore() *TypeSwitchStmt {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *TypeSwitchStmt) NewLineAfter() *TypeSwitchStmt {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *TypeSwitchStmt) EmptyLineAfter() *TypeSwitchStmt {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateSwitch appends decorations to the Switch decorations.
+func (b *TypeSwitchStmt) DecorateSwitch(decs ...string) *TypeSwitchStmt {
+	b.Node.Decs.Switch.Append(decs...)
+	return b
+}
+
+// DecorateInit appends decorations to the Init decorations.
+func (b *TypeSwitchStmt) DecorateInit(decs ...string) *TypeSwitchStmt {
+	b.Node.Decs.Init.Append(decs...)
+	return b
+}
+
+// DecorateAssign appends decorations to the Assign decorations.
+func (b *TypeSwitchStmt) DecorateAssign(decs ...string) *TypeSwitchStmt {
+	b.Node.Decs.Assign.Append(decs...)
+	return b
+}
+
+// UnaryExpr holds a *dst.UnaryExpr with chainable methods to set decorations. Use Build to get the
+// *dst.UnaryExpr.
+type UnaryExpr struct {
+	Node *dst.UnaryExpr
+}
+
+// Build returns the *dst.UnaryExpr.
+func (b *UnaryExpr) Build() *dst.UnaryExpr {
+	return b.Node
+}
+
+// BuildExpr returns the *dst.UnaryExpr. It implements ExprBuilder.
+func (b *UnaryExpr) BuildExpr() dst.Expr {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *UnaryExpr) WithComment(comments ...string) *UnaryExpr {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *UnaryExpr) WithEndComment(comments ...string) *UnaryExpr {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *UnaryExpr) NewLineBefore() *UnaryExpr {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *UnaryExpr) EmptyLineBefore() *UnaryExpr {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *UnaryExpr) NewLineAfter() *UnaryExpr {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *UnaryExpr) EmptyLineAfter() *UnaryExpr {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateOp appends decorations to the Op decorations.
+func (b *UnaryExpr) DecorateOp(decs ...string) *UnaryExpr {
+	b.Node.Decs.Op.Append(decs...)
+	return b
+}
+
+// ValueSpec holds a *dst.ValueSpec with chainable methods to set decorations. Use Build to get the
+// *dst.ValueSpec.
+type ValueSpec struct {
+	Node *dst.ValueSpec
+}
+
+// Build returns the *dst.ValueSpec.
+func (b *ValueSpec) Build() *dst.ValueSpec {
+	return b.Node
+}
+
+// WithComment appends comments to the Start decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ValueSpec) WithComment(comments ...string) *ValueSpec {
+	b.Node.Decs.Start.Append(comments...)
+	return b
+}
+
+// WithEndComment appends comments to the End decorations. Comments should include the "//" or
+// "/*" markers.
+func (b *ValueSpec) WithEndComment(comments ...string) *ValueSpec {
+	b.Node.Decs.End.Append(comments...)
+	return b
+}
+
+// NewLineBefore sets the Before space to dst.NewLine.
+func (b *ValueSpec) NewLineBefore() *ValueSpec {
+	b.Node.Decs.Before = dst.NewLine
+	return b
+}
+
+// EmptyLineBefore sets the Before space to dst.EmptyLine.
+func (b *ValueSpec) EmptyLineBefore() *ValueSpec {
+	b.Node.Decs.Before = dst.EmptyLine
+	return b
+}
+
+// NewLineAfter sets the After space to dst.NewLine.
+func (b *ValueSpec) NewLineAfter() *ValueSpec {
+	b.Node.Decs.After = dst.NewLine
+	return b
+}
+
+// EmptyLineAfter sets the After space to dst.EmptyLine.
+func (b *ValueSpec) EmptyLineAfter() *ValueSpec {
+	b.Node.Decs.After = dst.EmptyLine
+	return b
+}
+
+// DecorateAssign appends decorations to the Assign decorations.
+func (b *ValueSpec) DecorateAssign(decs ...string) *ValueSpec {
+	b.Node.Decs.Assign.Append(decs...)
+	return b
+}
@@ -345,6 +345,34 @@ func Clone(n Node) Node {
 
 		out.Decs.After = n.Decs.After
 
+		return out
+	case *CommentDecl:
+		out := &CommentDecl{}
+
+		out.Decs.Before = n.Decs.Before
+
+		// Decoration: Start
+		out.Decs.Start = append(out.Decs.Start, n.Decs.Start...)
+
+		// Decoration: End
+		out.Decs.End = append(out.Decs.End, n.Decs.End...)
+
+		out.Decs.After = n.Decs.After
+
+		return out
+	case *CommentStmt:
+		out := &CommentStmt{}
+
+		out.Decs.Before = n.Decs.Before
+
+		// Decoration: Start
+		out.Decs.Start = append(out.Decs.Start, n.Decs.Start...)
+
+		// Decoration: End
+		out.Decs.End = append(out.Decs.End, n.Decs.End...)
+
+		out.Decs.After = n.Decs.After
+
 		return out
 	case *CompositeLit:
 		out := &CompositeLit{}
@@ -0,0 +1,323 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"html"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+	"github.com/dave/dst/dstutil"
+	"github.com/dave/dst/meta"
+)
+
+// dumpUsage describes the dump command.
+const dumpUsage = `usage: dst dump [-lines from-to] [-type types] [-all] [-html] file.go
+
+Dump prints the dst tree of a file. Each node is printed with the field it's in, its position in
+the source, its values (e.g. Ident.Name), its line spacing and the decorations attached to each
+of its decoration points. With -html, the source is printed as an HTML page with the decoration
+points marked, and each comment links to the point it's attached to.
+
+Flags:
+`
+
+// dumpCommand runs the dump command.
+func dumpCommand(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("dump", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), dumpUsage)
+		flags.PrintDefaults()
+	}
+	lines := flags.String("lines", "", "only print nodes on these lines (e.g. 10-20 or 15)")
+	types := flags.String("type", "", "only print nodes of these comma separated types (e.g. FuncDecl,Ident)")
+	all := flags.Bool("all", false, "include empty decoration points")
+	htmlOutput := flags.Bool("html", false, "print the source as HTML with the decoration points marked")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return fmt.Errorf("expected a single file")
+	}
+	filter, err := newDumpFilter(*lines, *types)
+	if err != nil {
+		return err
+	}
+	fpath := flags.Arg(0)
+	src, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return err
+	}
+	d := decorator.NewDecorator(token.NewFileSet())
+	f, err := d.ParseFile(fpath, src, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	if *htmlOutput {
+		return dumpHTML(stdout, d, f, src, filter, *all)
+	}
+	return dumpTree(stdout, d, f, filter, *all)
+}
+
+// dumpFilter selects the nodes that are printed.
+type dumpFilter struct {
+	from, to int             // the line range, or zero for all lines
+	types    map[string]bool // the node types, or nil for all types
+}
+
+func newDumpFilter(lines, types string) (*dumpFilter, error) {
+	filter := &dumpFilter{}
+	if lines != "" {
+		from, to := lines, lines
+		if i := strings.Index(lines, "-"); i >= 0 {
+			from, to = lines[:i], lines[i+1:]
+		}
+		var err1, err2 error
+		filter.from, err1 = strconv.Atoi(from)
+		filter.to, err2 = strconv.Atoi(to)
+		if err1 != nil || err2 != nil || filter.from < 1 || filter.to < filter.from {
+			return nil, fmt.Errorf("invalid line range %q", lines)
+		}
+	}
+	if types != "" {
+		filter.types = map[string]bool{}
+		for _, t := range strings.Split(types, ",") {
+			t = strings.TrimPrefix(strings.TrimSpace(t), "dst.")
+			if meta.Lookup(t) == nil {
+				return nil, fmt.Errorf("unknown node type %q", t)
+			}
+			filter.types[t] = true
+		}
+	}
+	return filter, nil
+}
+
+// match reports whether n should be printed. Nodes added after decoration have no position, so
+// they only match if there is no line range.
+func (filter *dumpFilter) match(d *decorator.Decorator, n dst.Node) bool {
+	if filter.types != nil && !filter.types[nodeName(n)] {
+		return false
+	}
+	if filter.from == 0 {
+		return true
+	}
+	an, ok := d.Ast.Nodes[n]
+	if !ok {
+		return false
+	}
+	return d.Fset.Position(an.Pos()).Line <= filter.to && d.Fset.Position(an.End()).Line >= filter.from
+}
+
+// dumpTree prints the tree with one line per node, indented by depth. If a filter is used, the
+// full path of each node is printed instead of the indent and field.
+func dumpTree(w io.Writer, d *decorator.Decorator, f *dst.File, filter *dumpFilter, all bool) error {
+	var err error
+	filtered := filter.from != 0 || filter.types != nil
+	var visit func(n dst.Node, path, field string, depth int)
+	visit = func(n dst.Node, path, field string, depth int) {
+		if err != nil {
+			return
+		}
+		if filter.match(d, n) {
+			sb := &strings.Builder{}
+			switch {
+			case filtered:
+				// the parents may not be printed, so the full path is needed
+				if path != "" {
+					sb.WriteString(path + ": ")
+				}
+			default:
+				sb.WriteString(strings.Repeat("  ", depth))
+				if field != "" {
+					sb.WriteString(field + ": ")
+				}
+			}
+			sb.WriteString(nodeName(n))
+			if p := d.Position(n); p.IsValid() {
+				fmt.Fprintf(sb, " %d:%d", p.Line, p.Column)
+			}
+			for _, v := range nodeValues(n) {
+				sb.WriteString(" " + v)
+			}
+			for _, dec := range nodeDecorations(n, all) {
+				sb.WriteString(" " + dec)
+			}
+			_, err = fmt.Fprintln(w, sb.String())
+		}
+		eachChild(n, func(field string, c dst.Node) {
+			childPath := field
+			if path != "" {
+				childPath = path + "." + field
+			}
+			visit(c, childPath, field, depth+1)
+		})
+	}
+	visit(f, "", "", 0)
+	return err
+}
+
+// eachChild calls f for each child of n with the name of its field, e.g. "Decls[0]".
+func eachChild(n dst.Node, f func(field string, c dst.Node)) {
+	m := meta.Of(n)
+	if m == nil {
+		return
+	}
+	for _, field := range m.Fields {
+		if field.Derived {
+			continue
+		}
+		switch field.Kind {
+		case meta.NodeField:
+			if c, ok := field.Get(n).(dst.Node); ok {
+				f(field.Name, c)
+			}
+		case meta.ListField:
+			for i, c := range field.Get(n).([]dst.Node) {
+				if c != nil {
+					f(fmt.Sprintf("%s[%d]", field.Name, i), c)
+				}
+			}
+		case meta.MapField:
+			children := field.Get(n).(map[string]dst.Node)
+			var keys []string
+			for k := range children {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				f(fmt.Sprintf("%s[%q]", field.Name, k), children[k])
+			}
+		}
+	}
+}
+
+// nodeName returns the name of the type of n without the package, e.g. "Ident".
+func nodeName(n dst.Node) string {
+	return reflect.TypeOf(n).Elem().Name()
+}
+
+// nodeValues returns the token and value fields of n that aren't the zero value, e.g. Name=a.
+func nodeValues(n dst.Node) []string {
+	var out []string
+	for _, field := range meta.Of(n).Fields {
+		if field.Kind != meta.TokenField && field.Kind != meta.ValueField {
+			continue
+		}
+		v := field.Get(n)
+		if v == nil || reflect.ValueOf(v).IsZero() {
+			continue
+		}
+		if s, ok := v.(string); ok {
+			v = strconv.Quote(s)
+		}
+		out = append(out, fmt.Sprintf("%s=%v", field.Name, v))
+	}
+	return out
+}
+
+// nodeDecorations describes the line spacing and decorations of n, e.g. [Start "// a"]. Empty
+// decoration points are only included if all is set.
+func nodeDecorations(n dst.Node, all bool) []string {
+	var out []string
+	before, after, points := dstutil.Decorations(n)
+	if before != dst.None {
+		out = append(out, fmt.Sprintf("[Before %s]", before))
+	}
+	for _, point := range points {
+		if len(point.Decs) == 0 && !all {
+			continue
+		}
+		s := "[" + point.Name
+		for _, dec := range point.Decs {
+			s += " " + strconv.Quote(dec)
+		}
+		out = append(out, s+"]")
+	}
+	if after != dst.None {
+		out = append(out, fmt.Sprintf("[After %s]", after))
+	}
+	return out
+}
+
+// dumpHTML prints the source as an HTML page. Each decoration point that matches the filter is
+// marked, and each comment attached to one links to it. Empty decoration points are only marked
+// if all is set.
+func dumpHTML(w io.Writer, d *decorator.Decorator, f *dst.File, src []byte, filter *dumpFilter, all bool) error {
+	points, err := d.Points(f)
+	if err != nil {
+		return err
+	}
+
+	// insertions are added to the source at an offset: at the same offset, closing tags come
+	// before points, which come before opening tags.
+	type insertion struct {
+		offset, order int
+		html          string
+	}
+	var insertions []insertion
+	for i, p := range points {
+		if !filter.match(d, p.Node) || (len(p.Comments) == 0 && !all) {
+			continue
+		}
+		id := fmt.Sprintf("p%d", i)
+		title := html.EscapeString(fmt.Sprintf("%s.%s %d:%d", nodeName(p.Node), p.Name, p.Pos.Line, p.Pos.Column))
+		insertions = append(insertions, insertion{
+			offset: p.Pos.Offset,
+			order:  1,
+			html:   fmt.Sprintf(`<span class="point" id="%s" title="%s">%s.%s</span>`, id, title, nodeName(p.Node), p.Name),
+		})
+		for _, c := range p.Comments {
+			insertions = append(insertions,
+				insertion{offset: d.Fset.Position(c.Pos()).Offset, order: 2, html: fmt.Sprintf(`<a class="comment" href="#%s" title="%s">`, id, title)},
+				insertion{offset: d.Fset.Position(c.End()).Offset, order: 0, html: `</a>`},
+			)
+		}
+	}
+	sort.SliceStable(insertions, func(i, j int) bool {
+		if insertions[i].offset != insertions[j].offset {
+			return insertions[i].offset < insertions[j].offset
+		}
+		return insertions[i].order < insertions[j].order
+	})
+
+	sb := &strings.Builder{}
+	sb.WriteString(dumpHTMLHeader)
+	var offset int
+	for _, ins := range insertions {
+		sb.WriteString(html.EscapeString(string(src[offset:ins.offset])))
+		sb.WriteString(ins.html)
+		offset = ins.offset
+	}
+	sb.WriteString(html.EscapeString(string(src[offset:])))
+	sb.WriteString(dumpHTMLFooter)
+	_, err = io.WriteString(w, sb.String())
+	return err
+}
+
+const dumpHTMLHeader = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<style>
+pre { line-height: 1.6; }
+.point { font-size: 70%; color: #fff; background: #36c; border-radius: 3px; padding: 0 2px; margin: 0 1px; }
+.point:target { background: #c33; }
+.comment { color: #080; text-decoration: none; border-bottom: 1px dotted #080; }
+</style>
+</head>
+<body>
+<pre>`
+
+const dumpHTMLFooter = `</pre>
+</body>
+</html>
+`
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDump(t *testing.T) {
+	code := "package a\n\n// a doc\nfunc a() {\n\tb() // b\n}\n"
+	tests := []struct {
+		name   string
+		args   []string
+		expect string
+	}{
+		{
+			name: "tree",
+			expect: "File 1:1\n" +
+				"  Name: Ident 1:9 Name=\"a\"\n" +
+				"  Decls[0]: FuncDecl 4:1 [Before EmptyLine] [Start \"// a doc\"]\n" +
+				"    Name: Ident 4:6 Name=\"a\"\n" +
+				"    Type: FuncType 4:1 Func=true\n" +
+				"      Params: FieldList 4:7 Opening=true Closing=true\n" +
+				"    Body: BlockStmt 4:10\n" +
+				"      List[0]: ExprStmt 5:2 [Before NewLine] [End \"// b\"] [After NewLine]\n" +
+				"        X: CallExpr 5:2\n" +
+				"          Fun: Ident 5:2 Name=\"b\"\n",
+		},
+		{
+			name: "filter",
+			args: []string{"-lines", "5-6", "-type", "CallExpr,dst.Ident", "-all"},
+			expect: "Decls[0].Body.List[0].X: CallExpr 5:2 [Start] [Fun] [Lparen] [Ellipsis] [End]\n" +
+				"Decls[0].Body.List[0].X.Fun: Ident 5:2 Name=\"b\" [Start] [X] [End]\n",
+		},
+		{
+			name:   "html",
+			args:   []string{"-html"},
+			expect: "<span class=\"point\" id=\"p26\" title=\"ExprStmt.End 5:5\">ExprStmt.End</span> <a class=\"comment\" href=\"#p26\" title=\"ExprStmt.End 5:5\">// b</a>",
+		},
+	}
+	fpath := filepath.Join(t.TempDir(), "a.go")
+	if err := ioutil.WriteFile(fpath, []byte(code), 0666); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			if err := run(append(append([]string{"dump"}, test.args...), fpath), buf); err != nil {
+				t.Fatal(err)
+			}
+			if test.name == "html" {
+				if !strings.Contains(buf.String(), test.expect) {
+					t.Errorf("expected output to contain:\n%s\nfound:\n%s", test.expect, buf.String())
+				}
+				return
+			}
+			if buf.String() != test.expect {
+				t.Errorf("expected:\n%s\nfound:\n%s", test.expect, buf.String())
+			}
+		})
+	}
+}
+
+func TestDumpErrors(t *testing.T) {
+	tests := map[string][]string{
+		"invalid line range \"5-2\"":  {"dump", "-lines", "5-2", "a.go"},
+		"unknown node type \"Foo\"":   {"dump", "-type", "Foo", "a.go"},
+		"expected a single file":      {"dump"},
+		"unknown command \"nothing\"": {"nothing"},
+	}
+	for expect, args := range tests {
+		err := run(args, &bytes.Buffer{})
+		if err == nil || !strings.HasPrefix(err.Error(), expect) {
+			t.Errorf("%v: expected error %q, found %v", args, expect, err)
+		}
+	}
+}
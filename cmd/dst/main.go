@@ -0,0 +1,49 @@
+// Command dst works with Go source code using the dst package, so comments are preserved.
+//
+// Usage:
+//
+//	dst rewrite -rules file [-w | -d | -l] [packages]
+//	dst dump [-lines from-to] [-type types] [-all] [-html] file.go
+//
+// Rewrite applies rewrite rules to the packages (default "."). See the rewrite command for the
+// format of the rules file. Dump prints the dst tree of a file with the decorations attached to
+// each node, to help find where a comment is attached.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// commands maps each subcommand to the function that runs it.
+var commands = map[string]func(args []string, stdout io.Writer) error{
+	"dump":    dumpCommand,
+	"rewrite": rewriteCommand,
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+}
+
+// run runs the subcommand named by the first argument.
+func run(args []string, stdout io.Writer) error {
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if len(args) == 0 {
+		return fmt.Errorf("usage: dst <command> [arguments]\ncommands: %s", strings.Join(names, ", "))
+	}
+	command, ok := commands[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %q\ncommands: %s", args[0], strings.Join(names, ", "))
+	}
+	return command(args[1:], stdout)
+}
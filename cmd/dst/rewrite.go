@@ -0,0 +1,473 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+	"github.com/dave/dst/decorator/resolver/gopackages"
+	"github.com/dave/dst/decorator/resolver/guess"
+	"github.com/dave/dst/dstutil"
+	"github.com/dave/dst/internal/linediff"
+	"github.com/dave/dst/meta"
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"golang.org/x/tools/go/packages"
+)
+
+// rewriteUsage describes the rewrite command and the format of the rules file.
+const rewriteUsage = `usage: dst rewrite -rules file [-w | -d | -l] [packages]
+
+Rewrite applies rewrite rules to the files of the packages (default "."). By default the
+rewritten files are printed. Only the declarations that contain a rewritten expression are
+reformatted.
+
+Each line of the rules file is a rule, an import, a comment starting with # or a blank line:
+
+	import "io/ioutil"
+	import alias "example.com/pkg"
+
+	# ioutil.ReadFile is deprecated
+	ioutil.ReadFile(f) -> os.ReadFile(f)
+	strings.Index(s, t) >= 0 -> strings.Contains(s, t)
+
+A rule is a pattern and a replacement, both Go expressions. As with gofmt -r, single character
+lowercase identifiers in the pattern are wildcards that match any expression, and are replaced
+by the matched expressions in the replacement. Qualified identifiers use the imports in the rules
+file (or the standard library package with the same name if it isn't imported), and match
+identifiers from the package with that path whatever it is called in the file. The imports of
+rewritten files are updated. The rules are tried in order, and each expression is rewritten once.
+
+Flags:
+`
+
+// rewriteCommand runs the rewrite command.
+func rewriteCommand(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("rewrite", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), rewriteUsage)
+		flags.PrintDefaults()
+	}
+	rulesFile := flags.String("rules", "", "the file containing the rewrite rules")
+	write := flags.Bool("w", false, "write the rewritten files")
+	diff := flags.Bool("d", false, "print a diff of the changes")
+	list := flags.Bool("l", false, "list the changed files")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *rulesFile == "" {
+		flags.Usage()
+		return fmt.Errorf("no rules file")
+	}
+	src, err := ioutil.ReadFile(*rulesFile)
+	if err != nil {
+		return err
+	}
+	rules, err := parseRules(src)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *rulesFile, err)
+	}
+	patterns := flags.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+
+	pkgs, err := decorator.Load(&packages.Config{Mode: packages.LoadSyntax}, patterns...)
+	if err != nil {
+		return err
+	}
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return pkg.Errors[0]
+		}
+		for _, f := range pkg.Syntax {
+			if rewriteFile(f, rules) == 0 {
+				continue
+			}
+			fpath := pkg.Decorator.Filenames[f]
+			before, err := ioutil.ReadFile(fpath)
+			if err != nil {
+				return err
+			}
+			buf := &bytes.Buffer{}
+			r := decorator.NewRestorerWithImports(pkg.PkgPath, gopackages.New(pkg.Dir))
+			if err := r.FprintMinimal(buf, pkg.Decorator, f, before); err != nil {
+				return fmt.Errorf("%s: %w", fpath, err)
+			}
+			after := buf.Bytes()
+			if bytes.Equal(before, after) {
+				continue
+			}
+			if *list {
+				fmt.Fprintln(stdout, fpath)
+			}
+			if *write {
+				if err := ioutil.WriteFile(fpath, after, 0666); err != nil {
+					return err
+				}
+			}
+			if *diff {
+				if err := printDiff(stdout, fpath, before, after); err != nil {
+					return err
+				}
+			}
+			if !*list && !*write && !*diff {
+				stdout.Write(after)
+			}
+		}
+	}
+	return nil
+}
+
+// rule rewrites expressions that match pattern with replacement.
+type rule struct {
+	pattern, replacement dst.Expr
+}
+
+// rulesPath is the package path used when parsing rules. Identifiers in rules are never local to
+// a package, so any path that can't be imported is fine.
+const rulesPath = "_rules"
+
+// parseRules parses a rules file.
+func parseRules(src []byte) ([]*rule, error) {
+	imports := map[string]string{}
+	type line struct {
+		number               int
+		pattern, replacement string
+	}
+	var lines []line
+	scanner := bufio.NewScanner(bytes.NewReader(src))
+	for number := 1; scanner.Scan(); number++ {
+		text := strings.TrimSpace(scanner.Text())
+		switch {
+		case text == "", strings.HasPrefix(text, "#"):
+		case strings.HasPrefix(text, "import "):
+			fields := strings.Fields(strings.TrimPrefix(text, "import "))
+			if len(fields) < 1 || len(fields) > 2 {
+				return nil, fmt.Errorf("line %d: expected import [name] \"path\"", number)
+			}
+			path, err := strconv.Unquote(fields[len(fields)-1])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid import path %s", number, fields[len(fields)-1])
+			}
+			name, err := guess.New().ResolvePackage(path)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", number, err)
+			}
+			if len(fields) == 2 {
+				name = fields[0]
+			}
+			imports[name] = path
+		default:
+			i := strings.Index(text, "->")
+			if i < 0 {
+				return nil, fmt.Errorf("line %d: expected pattern -> replacement", number)
+			}
+			lines = append(lines, line{number, text[:i], text[i+2:]})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	var rules []*rule
+	for _, l := range lines {
+		dec := decorator.NewSnippetDecorator(rulesPath, ruleImports(imports, l.pattern+" "+l.replacement))
+		pattern, err := dec.ParseExpr(l.pattern)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: pattern: %w", l.number, err)
+		}
+		replacement, err := dec.ParseExpr(l.replacement)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: replacement: %w", l.number, err)
+		}
+		if id, ok := pattern.(*dst.Ident); ok && isWildcard(id) {
+			return nil, fmt.Errorf("line %d: the pattern can't be a single wildcard", l.number)
+		}
+		clearDecorations(pattern)
+		clearDecorations(replacement)
+		rules = append(rules, &rule{pattern: pattern, replacement: replacement})
+	}
+	return rules, nil
+}
+
+// ruleImports returns the imports used to parse a rule: the imports from the rules file, and
+// any other qualifiers in the rule that aren't wildcards, which are taken to be standard library
+// packages with the same path as their name.
+func ruleImports(imports map[string]string, text string) map[string]string {
+	out := map[string]string{}
+	for name, path := range imports {
+		out[name] = path
+	}
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '.'
+	})
+	for _, field := range fields {
+		parts := strings.Split(field, ".")
+		if len(parts) < 2 || parts[0] == "" || len(parts[0]) == 1 {
+			continue
+		}
+		if _, ok := out[parts[0]]; !ok && !unicode.IsDigit(rune(parts[0][0])) {
+			out[parts[0]] = parts[0]
+		}
+	}
+	return out
+}
+
+// clearDecorations removes the line spacing and comments from a rule, so the rewritten code
+// takes its layout from the code that was matched.
+func clearDecorations(n dst.Node) {
+	dst.Inspect(n, func(n dst.Node) bool {
+		if n != nil {
+			n.Decorations().Before = dst.None
+			n.Decorations().After = dst.None
+		}
+		return true
+	})
+}
+
+// isWildcard reports whether id is a wildcard: a single character lowercase identifier.
+func isWildcard(id *dst.Ident) bool {
+	return id.Path == "" && len(id.Name) == 1 && unicode.IsLower(rune(id.Name[0]))
+}
+
+// rewriteFile applies the rules to the expressions in f, and returns the number of expressions
+// rewritten. Expressions are visited in depth first order, so the operands of an expression are
+// rewritten before the expression is matched.
+func rewriteFile(f *dst.File, rules []*rule) int {
+	var count int
+	dstutil.Apply(f, nil, func(c *dstutil.Cursor) bool {
+		n, ok := c.Node().(dst.Expr)
+		if !ok {
+			return true
+		}
+		for _, r := range rules {
+			matches := map[string]dst.Node{}
+			if !match(matches, r.pattern, n) {
+				continue
+			}
+			replacement, ok := substitute(matches, r.replacement)
+			if !ok || !assignable(c, replacement) {
+				continue
+			}
+			*replacement.Decorations() = *n.Decorations()
+			c.Replace(replacement)
+			count++
+			break
+		}
+		return true
+	})
+	return count
+}
+
+// match reports whether n matches the pattern, and records the expressions matched by the
+// wildcards in matches. A wildcard that is used more than once must match equal expressions.
+func match(matches map[string]dst.Node, pattern, n dst.Node) bool {
+	if pattern == nil || n == nil {
+		return pattern == nil && n == nil
+	}
+	if id, ok := pattern.(*dst.Ident); ok && isWildcard(id) {
+		if _, ok := n.(dst.Expr); !ok {
+			return false
+		}
+		if previous, ok := matches[id.Name]; ok {
+			return dst.Equal(previous, n, dst.IgnoreDecorations(), dst.IgnoreSpacing())
+		}
+		matches[id.Name] = n
+		return true
+	}
+	m := meta.Of(pattern)
+	if m == nil || meta.Of(n) != m {
+		return false
+	}
+	for _, f := range m.Fields {
+		switch f.Kind {
+		case meta.NodeField:
+			p, _ := f.Get(pattern).(dst.Node)
+			c, _ := f.Get(n).(dst.Node)
+			if !match(matches, p, c) {
+				return false
+			}
+		case meta.ListField:
+			if f.Derived {
+				continue
+			}
+			p, c := f.Get(pattern).([]dst.Node), f.Get(n).([]dst.Node)
+			if len(p) != len(c) {
+				return false
+			}
+			for i := range p {
+				if !match(matches, p[i], c[i]) {
+					return false
+				}
+			}
+		case meta.MapField:
+			return false
+		default:
+			if f.Get(pattern) != f.Get(n) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// substitute returns a copy of the replacement with the wildcards replaced by the matched
+// expressions. The matched expressions are moved into the result, so their comments are kept:
+// if a wildcard is used more than once, the other uses are copies. It returns false if a wildcard
+// isn't matched by the pattern, or the matched expression can't be used where the wildcard is.
+func substitute(matches map[string]dst.Node, replacement dst.Expr) (dst.Expr, bool) {
+	if id, ok := replacement.(*dst.Ident); ok && isWildcard(id) {
+		n, ok := matches[id.Name]
+		if !ok {
+			return nil, false
+		}
+		return n.(dst.Expr), true
+	}
+	result := dst.Clone(replacement).(dst.Expr)
+	used := map[string]bool{}
+	ok := true
+	dstutil.Apply(result, func(c *dstutil.Cursor) bool {
+		id, isIdent := c.Node().(*dst.Ident)
+		if !isIdent || !isWildcard(id) {
+			return true
+		}
+		n, found := matches[id.Name]
+		if !found {
+			ok = false
+			return false
+		}
+		if used[id.Name] {
+			n = dst.Clone(n)
+		}
+		used[id.Name] = true
+		if !assignable(c, n) {
+			ok = false
+			return false
+		}
+		c.Replace(n)
+		return false
+	}, nil)
+	return result, ok
+}
+
+// assignable reports whether n can replace the node at the cursor: fields that hold a specific
+// node type (e.g. SelectorExpr.Sel) can only hold a node of that type.
+func assignable(c *dstutil.Cursor, n dst.Node) bool {
+	m := meta.Of(c.Parent())
+	if m == nil {
+		return false
+	}
+	for _, f := range m.Fields {
+		if f.Name == c.Name() {
+			return f.Interface || meta.Of(n) == meta.Of(c.Node())
+		}
+	}
+	return false
+}
+
+// printDiff prints a unified diff of the changes to a file, with three lines of context around
+// each change.
+func printDiff(w io.Writer, fpath string, before, after []byte) error {
+	const context = 3
+
+	// flatten the operations to one entry per line
+	type line struct {
+		op   diffmatchpatch.Operation
+		text string
+	}
+	var lines []line
+	for _, op := range linediff.Diff(string(before), string(after)) {
+		for _, text := range op.Lines {
+			lines = append(lines, line{op.Type, text})
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "--- %s.orig\n+++ %s\n", fpath, fpath)
+	var oldLine, newLine int // the number of lines before lines[i] in before and after
+	var changed bool
+	for i := 0; i < len(lines); {
+		if lines[i].op == diffmatchpatch.DiffEqual {
+			oldLine++
+			newLine++
+			i++
+			continue
+		}
+		// the hunk starts with up to three lines of context, and ends after three lines of
+		// context following the last change that's within six lines of the previous one
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for j := i; j < len(lines) && j-end <= 2*context; j++ {
+			if lines[j].op != diffmatchpatch.DiffEqual {
+				end = j + 1
+			}
+		}
+		end += context
+		if end > len(lines) {
+			end = len(lines)
+		}
+		changed = true
+		oldStart, newStart := oldLine-(i-start), newLine-(i-start)
+		var oldCount, newCount int
+		for _, l := range lines[start:end] {
+			if l.op != diffmatchpatch.DiffInsert {
+				oldCount++
+			}
+			if l.op != diffmatchpatch.DiffDelete {
+				newCount++
+			}
+		}
+		fmt.Fprintf(buf, "@@ -%s +%s @@\n", hunkRange(oldStart, oldCount), hunkRange(newStart, newCount))
+		for _, l := range lines[start:end] {
+			switch l.op {
+			case diffmatchpatch.DiffEqual:
+				buf.WriteString(" ")
+			case diffmatchpatch.DiffDelete:
+				buf.WriteString("-")
+			case diffmatchpatch.DiffInsert:
+				buf.WriteString("+")
+			}
+			buf.WriteString(l.text)
+			if !strings.HasSuffix(l.text, "\n") {
+				buf.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		for _, l := range lines[i:end] {
+			if l.op != diffmatchpatch.DiffInsert {
+				oldLine++
+			}
+			if l.op != diffmatchpatch.DiffDelete {
+				newLine++
+			}
+		}
+		i = end
+	}
+	if !changed {
+		return nil
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// hunkRange formats the start line and number of lines of a hunk. A hunk with no lines starts at
+// the line before it.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return strconv.Itoa(start + 1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"bytes"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/dave/dst/decorator"
+	"github.com/dave/dst/decorator/resolver/goast"
+	"github.com/dave/dst/decorator/resolver/guess"
+)
+
+func TestRewrite(t *testing.T) {
+	tests := []struct {
+		name   string
+		rules  string
+		code   string
+		expect string
+		count  int
+	}{
+		{
+			name:   "wildcards",
+			rules:  "strings.Index(s, t) >= 0 -> strings.Contains(s, t)",
+			code:   "package a\n\nimport \"strings\"\n\nvar a = strings.Index(b, \"c\") >= 0 // d\n\nvar e  =  1\n",
+			expect: "package a\n\nimport \"strings\"\n\nvar a = strings.Contains(b, \"c\") // d\n\nvar e  =  1\n",
+			count:  1,
+		},
+		{
+			name:   "imports",
+			rules:  "import \"io/ioutil\"\n\n# deprecated\nioutil.ReadFile(f) -> os.ReadFile(f)\n",
+			code:   "package a\n\nimport (\n\tio \"io/ioutil\"\n)\n\nfunc a() {\n\tio.ReadFile(\"b\") // c\n}\n",
+			expect: "package a\n\nimport \"os\"\n\nfunc a() {\n\tos.ReadFile(\"b\") // c\n}\n",
+			count:  1,
+		},
+		{
+			name:   "repeated-wildcard",
+			rules:  "x + x -> 2 * x",
+			code:   "package a\n\nvar a = b + b\n\nvar c = b + d\n",
+			expect: "package a\n\nvar a = 2 * b\n\nvar c = b + d\n",
+			count:  1,
+		},
+		{
+			name:   "nested",
+			rules:  "foo(x) -> bar(x)",
+			code:   "package a\n\nvar a = foo(foo(b))\n",
+			expect: "package a\n\nvar a = bar(bar(b))\n",
+			count:  2,
+		},
+		{
+			name:   "comments-in-wildcard",
+			rules:  "!(x == y) -> x != y",
+			code:   "package a\n\nvar a = !(e(/* c */ b) == d)\n",
+			expect: "package a\n\nvar a = e( /* c */ b) != d\n",
+			count:  1,
+		},
+		{
+			name:   "no-match",
+			rules:  "strings.Index(s, t) >= 0 -> strings.Contains(s, t)",
+			code:   "package a\n\nfunc Index(s, t string) int { return 0 }\n\nvar a = Index(b, \"c\") >= 0\n",
+			expect: "package a\n\nfunc Index(s, t string) int { return 0 }\n\nvar a = Index(b, \"c\") >= 0\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rules, err := parseRules([]byte(test.rules))
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := decorator.NewDecoratorWithImports(token.NewFileSet(), "a", goast.New())
+			f, err := d.Parse(test.code)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count := rewriteFile(f, rules); count != test.count {
+				t.Errorf("expected %d rewrites, found %d", test.count, count)
+			}
+			buf := &bytes.Buffer{}
+			if err := decorator.NewRestorerWithImports("a", guess.New()).FprintMinimal(buf, d, f, []byte(test.code)); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != test.expect {
+				t.Errorf("expected:\n%s\nfound:\n%s", test.expect, buf.String())
+			}
+		})
+	}
+}
+
+func TestParseRulesError(t *testing.T) {
+	tests := map[string]string{
+		"a + b":          "line 1: expected pattern -> replacement",
+		"import":         "line 1: expected pattern -> replacement",
+		"import a b c":   "line 1: expected import [name] \"path\"",
+		"\na -> b":       "line 2: the pattern can't be a single wildcard",
+		"a + -> b":       "line 1: pattern:",
+		"import \"a":     "line 1: invalid import path \"a",
+		"a + b -> c + ]": "line 1: replacement:",
+	}
+	for rules, expect := range tests {
+		_, err := parseRules([]byte(rules))
+		if err == nil || !strings.HasPrefix(err.Error(), expect) {
+			t.Errorf("%q: expected error %q, found %v", rules, expect, err)
+		}
+	}
+}
+
+func TestPrintDiff(t *testing.T) {
+	tests := []struct {
+		name          string
+		before, after string
+		expect        string
+	}{
+		{
+			name:   "unchanged",
+			before: "a\nb\n",
+			after:  "a\nb\n",
+			expect: "",
+		},
+		{
+			name:   "change",
+			before: "a\nb\nc\nd\ne\nf\n",
+			after:  "a\nb\nc\nD\ne\nf\n",
+			expect: "--- a.go.orig\n+++ a.go\n@@ -1,6 +1,6 @@\n a\n b\n c\n-d\n+D\n e\n f\n",
+		},
+		{
+			name:   "two-hunks",
+			before: "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\n",
+			after:  "A\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\n",
+			expect: "--- a.go.orig\n+++ a.go\n@@ -1,4 +1,4 @@\n-a\n+A\n b\n c\n d\n@@ -8,3 +8,4 @@\n h\n i\n j\n+k\n",
+		},
+		{
+			name:   "insert-into-empty",
+			before: "",
+			after:  "a\n",
+			expect: "--- a.go.orig\n+++ a.go\n@@ -0,0 +1 @@\n+a\n",
+		},
+		{
+			name:   "no-newline",
+			before: "a\nb\n",
+			after:  "a\nb",
+			expect: "--- a.go.orig\n+++ a.go\n@@ -1,2 +1,2 @@\n a\n-b\n+b\n\\ No newline at end of file\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			if err := printDiff(buf, "a.go", []byte(test.before), []byte(test.after)); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != test.expect {
+				t.Errorf("expected:\n%s\nfound:\n%s", test.expect, buf.String())
+			}
+		})
+	}
+}
@@ -67,6 +67,16 @@ func (n *CommClause) Decorations() *NodeDecs {
 	return &n.Decs.NodeDecs
 }
 
+// Decorations returns the decorations that are common to all nodes (Before, Start, End, After).
+func (n *CommentDecl) Decorations() *NodeDecs {
+	return &n.Decs.NodeDecs
+}
+
+// Decorations returns the decorations that are common to all nodes (Before, Start, End, After).
+func (n *CommentStmt) Decorations() *NodeDecs {
+	return &n.Decs.NodeDecs
+}
+
 // Decorations returns the decorations that are common to all nodes (Before, Start, End, After).
 func (n *CompositeLit) Decorations() *NodeDecs {
 	return &n.Decs.NodeDecs
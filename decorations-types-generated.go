@@ -129,6 +129,18 @@ type CommClauseDecorations struct {
 	Colon Decorations
 }
 
+// CommentDeclDecorations holds decorations for CommentDecl:
+//
+type CommentDeclDecorations struct {
+	NodeDecs
+}
+
+// CommentStmtDecorations holds decorations for CommentStmt:
+//
+type CommentStmtDecorations struct {
+	NodeDecs
+}
+
 // CompositeLitDecorations holds decorations for CompositeLit:
 //
 // 	var D = /*Start*/ A /*Type*/ { /*Lbrace*/ A: 0} /*End*/
@@ -1,5 +1,7 @@
 package dst
 
+import "strings"
+
 // NodeDecs holds the decorations that are common to all nodes (except Package).
 type NodeDecs struct {
 	Before SpaceType
@@ -36,6 +38,122 @@ func (d *Decorations) All() []string {
 	return *d
 }
 
+// Entries returns the decorations as structured entries. A "\n" that follows a block comment sets
+// the Newline field of the comment, and any other "\n" is a NewlineDecoration entry (so a
+// NewlineDecoration after a line comment is an empty line). Decorations that aren't a newline or a
+// comment (see Validate) are returned as line comments.
+func (d *Decorations) Entries() []Decoration {
+	var entries []Decoration
+	for _, s := range *d {
+		switch {
+		case s == "\n":
+			if n := len(entries); n > 0 && entries[n-1].Kind == BlockComment && !entries[n-1].Newline {
+				entries[n-1].Newline = true
+				continue
+			}
+			entries = append(entries, Decoration{Kind: NewlineDecoration})
+		case strings.HasPrefix(s, "/*") && strings.HasSuffix(s, "*/") && len(s) >= 4:
+			entries = append(entries, Decoration{Kind: BlockComment, Text: s[2 : len(s)-2]})
+		default:
+			entries = append(entries, Decoration{Kind: LineComment, Text: strings.TrimPrefix(s, "//"), Newline: true})
+		}
+	}
+	return entries
+}
+
+// SetEntries replaces all decorations with entries.
+func (d *Decorations) SetEntries(entries ...Decoration) {
+	var decs Decorations
+	for _, e := range entries {
+		decs = append(decs, e.Strings()...)
+	}
+	*d = decs
+}
+
+// Decoration is a single decoration in structured form. See Decorations.Entries.
+type Decoration struct {
+	Kind DecorationKind
+	// Text is the text of a comment without the comment markers, e.g. " foo" for "// foo" or
+	// "go:generate foo" for "//go:generate foo". Text is empty for newlines. If the Text of a
+	// LineComment has more than one line, each line is rendered as a separate line comment.
+	Text string
+	// Newline is true if a newline follows a comment. Line comments are always followed by a
+	// newline.
+	Newline bool
+}
+
+// Strings returns the decoration in the form used by Decorations.
+func (d Decoration) Strings() []string {
+	switch d.Kind {
+	case NewlineDecoration:
+		return []string{"\n"}
+	case LineComment:
+		var out []string
+		for _, line := range strings.Split(d.Text, "\n") {
+			out = append(out, "//"+line)
+		}
+		return out
+	case BlockComment:
+		if d.Newline {
+			return []string{"/*" + d.Text + "*/", "\n"}
+		}
+		return []string{"/*" + d.Text + "*/"}
+	}
+	return nil
+}
+
+// IsDirective returns true if the decoration is a directive comment, e.g. "//go:generate foo",
+// "//nolint:errcheck", "//line a.go:10", "//export foo" or "/*line a.go:10*/". The rules match
+// the go/ast package, which excludes directives from CommentGroup.Text.
+func (d Decoration) IsDirective() bool {
+	switch d.Kind {
+	case LineComment:
+		if strings.HasPrefix(d.Text, "line ") || strings.HasPrefix(d.Text, "extern ") || strings.HasPrefix(d.Text, "export ") {
+			return true
+		}
+		// "//[a-z0-9]+:[a-z0-9]"
+		colon := strings.Index(d.Text, ":")
+		if colon <= 0 || colon+1 >= len(d.Text) {
+			return false
+		}
+		for i := 0; i <= colon+1; i++ {
+			if i == colon {
+				continue
+			}
+			b := d.Text[i]
+			if !('a' <= b && b <= 'z' || '0' <= b && b <= '9') {
+				return false
+			}
+		}
+		return true
+	case BlockComment:
+		return strings.HasPrefix(d.Text, "line ")
+	}
+	return false
+}
+
+// DecorationKind is the kind of a Decoration.
+type DecorationKind int
+
+const (
+	NewlineDecoration DecorationKind = 0 // NewlineDecoration is a "\n"
+	LineComment       DecorationKind = 1 // LineComment is a "//" comment
+	BlockComment      DecorationKind = 2 // BlockComment is a "/* */" comment
+)
+
+// String returns a human readable representation of the decoration kind
+func (k DecorationKind) String() string {
+	switch k {
+	case NewlineDecoration:
+		return "NewlineDecoration"
+	case LineComment:
+		return "LineComment"
+	case BlockComment:
+		return "BlockComment"
+	}
+	return ""
+}
+
 // SpaceType represents the line spacing before or after a node. When the start of one node is
 // adjacent to the end of another node, the SpaceType values are not additive (e.g. two NewLines
 // will render a NewLine and not an EmptyLine).
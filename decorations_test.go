@@ -9,6 +9,7 @@ import (
 	"go/types"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/dave/dst"
@@ -70,6 +71,74 @@ func TestDecorations_Prepend(t *testing.T) {
 	}
 }
 
+func TestDecorations_Entries(t *testing.T) {
+	d := &dst.Decorations{"// a", "\n", "/* b */", "\n", "\n", "/* c */", "//go:generate d"}
+	expected := []dst.Decoration{
+		{Kind: dst.LineComment, Text: " a", Newline: true},
+		{Kind: dst.NewlineDecoration},
+		{Kind: dst.BlockComment, Text: " b ", Newline: true},
+		{Kind: dst.NewlineDecoration},
+		{Kind: dst.BlockComment, Text: " c "},
+		{Kind: dst.LineComment, Text: "go:generate d", Newline: true},
+	}
+	found := d.Entries()
+	if fmt.Sprint(expected) != fmt.Sprint(found) {
+		t.Fatalf("expected %v, found %v", expected, found)
+	}
+	d.SetEntries(found...)
+	if fmt.Sprintf("%q", *d) != `["// a" "\n" "/* b */" "\n" "\n" "/* c */" "//go:generate d"]` {
+		t.Fatalf("round trip failed, found %q", *d)
+	}
+}
+
+func TestDecorations_SetEntries(t *testing.T) {
+	d := &dst.Decorations{"/* a\nb */", "\n", "/* c */"}
+	entries := d.Entries()
+	for i, e := range entries {
+		if e.Kind == dst.BlockComment {
+			// change block comments to line comments
+			entries[i] = dst.Decoration{Kind: dst.LineComment, Text: strings.TrimSuffix(e.Text, " "), Newline: true}
+		}
+	}
+	d.SetEntries(entries...)
+	found := fmt.Sprintf("%q", *d)
+	expected := `["// a" "//b" "// c"]`
+	if expected != found {
+		t.Fatalf("expected %s, found %s", expected, found)
+	}
+}
+
+func TestDecoration_IsDirective(t *testing.T) {
+	tests := map[string]bool{
+		"//go:generate a":     true,
+		"//nolint:errcheck":   true,
+		"//line a.go:1":       true,
+		"//export a":          true,
+		"/*line a.go:1*/":     true,
+		"// go:generate a":    false,
+		"//Go:generate a":     false,
+		"//a:":                false,
+		"//:a":                false,
+		"/* go:generate a */": false,
+		"// a":                false,
+	}
+	for s, expected := range tests {
+		d := dst.Decorations{s}
+		if found := d.Entries()[0].IsDirective(); found != expected {
+			t.Errorf("%q: expected %v, found %v", s, expected, found)
+		}
+	}
+}
+
+func TestDecorationKind_String(t *testing.T) {
+	if dst.LineComment.String() != "LineComment" {
+		t.Fatalf("expected LineComment, found %s", dst.LineComment.String())
+	}
+	if dst.DecorationKind(99).String() != "" {
+		t.Fatalf("expected , found %s", dst.DecorationKind(99).String())
+	}
+}
+
 func TestSpaceType_String(t *testing.T) {
 	if dst.None.String() != "None" {
 		t.Fatalf("expected None, found %s", dst.None.String())
@@ -0,0 +1,93 @@
+package decorator
+
+import (
+	"bytes"
+	"go/token"
+	"testing"
+)
+
+func TestAttachmentPolicy(t *testing.T) {
+	code := `package a
+
+func a() {
+	b := 1 // b1
+	       // b2
+	// c
+	c := 2
+	// d
+
+	d := 3
+
+	// e
+
+	e := 4
+	_, _, _, _ = b, c, d, e
+}`
+	tests := []struct {
+		name   string
+		policy AttachmentPolicy
+		expect string
+	}{
+		{
+			name: "default",
+			expect: `FuncDecl [Empty line before]
+AssignStmt [New line before] [End "// b1"] [New line after]
+AssignStmt [New line before] [Start "// b2" "// c"] [End "\n" "// d"] [Empty line after]
+AssignStmt [Empty line before] [Empty line after]
+AssignStmt [Empty line before] [Start "// e" "\n"] [New line after]
+AssignStmt [New line before] [New line after]`,
+		},
+		{
+			name:   "prefer-following",
+			policy: AttachmentPolicy{PreferFollowing: true},
+			expect: `FuncDecl [Empty line before]
+AssignStmt [New line before] [End "// b1"] [New line after]
+AssignStmt [New line before] [Start "// b2" "// c"] [New line after]
+AssignStmt [New line before] [Start "// d" "\n"] [Empty line after]
+AssignStmt [Empty line before] [Start "// e" "\n"] [New line after]
+AssignStmt [New line before] [New line after]`,
+		},
+		{
+			name:   "trailing-continuations",
+			policy: AttachmentPolicy{TrailingContinuations: true},
+			expect: `FuncDecl [Empty line before]
+AssignStmt [New line before] [End "// b1" "// b2"] [New line after]
+AssignStmt [New line before] [Start "// c"] [End "\n" "// d"] [Empty line after]
+AssignStmt [Empty line before] [Empty line after]
+AssignStmt [Empty line before] [Start "// e" "\n"] [New line after]
+AssignStmt [New line before] [New line after]`,
+		},
+		{
+			name:   "float-separated",
+			policy: AttachmentPolicy{FloatSeparated: true},
+			expect: `FuncDecl [Empty line before]
+AssignStmt [New line before] [End "// b1"] [New line after]
+AssignStmt [New line before] [Start "// b2" "// c"] [End "\n" "// d"] [Empty line after]
+AssignStmt [Empty line before] [End "\n" "\n" "// e"] [Empty line after]
+AssignStmt [Empty line before] [New line after]
+AssignStmt [New line before] [New line after]`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := NewDecorator(token.NewFileSet())
+			d.AttachmentPolicy = test.policy
+			f, err := d.Parse(code)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			buf := &bytes.Buffer{}
+			debug(buf, f)
+			if normalize(test.expect) != normalize(buf.String()) {
+				t.Errorf("diff:\n%s", diff(normalize(test.expect), normalize(buf.String())))
+			}
+
+			buf = &bytes.Buffer{}
+			if err := Fprint(buf, f); err != nil {
+				t.Fatal(err)
+			}
+			compareSrc(t, code, buf.String())
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package decorator
+
+import (
+	"go/ast"
+	"go/token"
+	"sort"
+	"strings"
+
+	"github.com/dave/dst"
+)
+
+// floatingDecoration is the Attached value of the comment and newline fragments of free-floating
+// comments, so they aren't attached to another decoration.
+var floatingDecoration = &decorationFragment{Name: "Floating"}
+
+// floatingComment is a group of free-floating comments that is decorated as a CommentStmt in a
+// BlockStmt or a CommentDecl in a File.
+type floatingComment struct {
+	Container ast.Node // *ast.BlockStmt or *ast.File
+	Index     int      // the index in the List or Decls of the container
+	Decs      dst.Decorations
+	After     dst.SpaceType
+}
+
+// findFloatingComments finds groups of comments on consecutive lines that have an empty line
+// before them and an empty line (or the closing brace of a block) after them, in the statements
+// of a block or the declarations of a file. The fragments are marked as attached so the rest of
+// the link algorithm ignores them.
+func (f *fileDecorator) findFloatingComments() {
+	type group struct {
+		first, last int // the indexes of the first and last fragments in the group
+		after       dst.SpaceType
+	}
+	var groups []group
+	var positions []token.Pos
+	for i := 1; i < len(f.fragments); i++ {
+		if _, ok := f.fragments[i].(*commentFragment); !ok {
+			continue
+		}
+		if nl, ok := f.fragments[i-1].(*newlineFragment); !ok || !nl.Empty {
+			continue
+		}
+
+		// find the last comment in the group
+		end := i
+		for j := i + 1; j < len(f.fragments); j++ {
+			if _, ok := f.fragments[j].(*commentFragment); ok {
+				end = j
+			} else if nl, ok := f.fragments[j].(*newlineFragment); !ok || nl.Empty {
+				break
+			}
+		}
+
+		// check what follows the group
+		last := end
+		after := dst.None
+		if end+1 < len(f.fragments) {
+			nl, ok := f.fragments[end+1].(*newlineFragment)
+			switch {
+			case !ok:
+				// code on the same line as the last comment
+				i = end
+				continue
+			case nl.Empty:
+				after = dst.EmptyLine
+			case end+2 == len(f.fragments) || isBlockRbrace(f.fragments[end+2]):
+				// the newline before the end of the file or the closing brace is part of the group
+				after = dst.NewLine
+				last = end + 1
+			default:
+				i = end
+				continue
+			}
+		}
+		groups = append(groups, group{first: i, last: last, after: after})
+		positions = append(positions, f.fragments[i].Position())
+		i = last
+	}
+
+	containers, indexes := f.floatingContainers(positions)
+	for gi, g := range groups {
+		if containers[gi] == nil {
+			continue
+		}
+		fc := &floatingComment{Container: containers[gi], Index: indexes[gi], After: g.after}
+		for _, frag := range f.fragments[g.first : g.last+1] {
+			switch frag := frag.(type) {
+			case *commentFragment:
+				fc.Decs = append(fc.Decs, frag.Text)
+				frag.Attached = floatingDecoration
+			case *newlineFragment:
+				if frag.Empty {
+					// only possible for the newline after the group, which is spacing
+					continue
+				}
+				if frag != f.fragments[g.last] && !isLineComment(fc.Decs) {
+					fc.Decs = append(fc.Decs, "\n")
+				}
+				frag.Attached = floatingDecoration
+			}
+		}
+		f.floating = append(f.floating, fc)
+	}
+}
+
+// isLineComment returns true if the last decoration is a line comment, which is always followed by
+// a newline.
+func isLineComment(decs dst.Decorations) bool {
+	return len(decs) > 0 && strings.HasPrefix(decs[len(decs)-1], "//")
+}
+
+func isBlockRbrace(frag fragment) bool {
+	t, ok := frag.(*tokenFragment)
+	if !ok || t.Token != token.RBRACE {
+		return false
+	}
+	_, ok = t.Node.(*ast.BlockStmt)
+	return ok
+}
+
+// floatingContainers returns the block or file that each free-floating comment is in, and the
+// index in the statements or declarations that it will be inserted at. The positions must be in
+// increasing order. A nil container is returned if the comment isn't between the statements of a
+// block or the declarations of a file (e.g. it's inside an expression or a switch statement). Each
+// file is walked once, only visiting the nodes that contain a comment.
+func (f *fileDecorator) floatingContainers(positions []token.Pos) (containers []ast.Node, indexes []int) {
+	containers = make([]ast.Node, len(positions))
+	indexes = make([]int, len(positions))
+	for _, file := range f.files {
+		tf := f.Fset.File(file.Pos())
+		blocks := make([]*ast.BlockStmt, len(positions))
+		clauses := map[*ast.BlockStmt]bool{}
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case nil:
+				return false
+			case *ast.File:
+				return true
+			case *ast.SwitchStmt:
+				clauses[n.Body] = true
+			case *ast.TypeSwitchStmt:
+				clauses[n.Body] = true
+			case *ast.SelectStmt:
+				clauses[n.Body] = true
+			}
+			// the comments inside n
+			from := sort.Search(len(positions), func(i int) bool { return positions[i] >= n.Pos() })
+			to := sort.Search(len(positions), func(i int) bool { return positions[i] >= n.End() })
+			if from == to {
+				return false
+			}
+			if b, ok := n.(*ast.BlockStmt); ok {
+				// blocks are visited before the blocks inside them, so the innermost block wins
+				for i := from; i < to; i++ {
+					blocks[i] = b
+				}
+			}
+			return true
+		})
+		for i, pos := range positions {
+			if f.Fset.File(pos) != tf || pos < file.Name.End() {
+				continue
+			}
+			var container ast.Node
+			var nodes []ast.Node
+			switch block := blocks[i]; {
+			case block == nil:
+				container = file
+				for _, d := range file.Decls {
+					nodes = append(nodes, d)
+				}
+			case clauses[block]:
+				continue
+			default:
+				container = block
+				for _, s := range block.List {
+					nodes = append(nodes, s)
+				}
+			}
+			// the nodes are in order, so find the first node that ends after the comment
+			index := sort.Search(len(nodes), func(j int) bool { return nodes[j].End() > pos })
+			if index < len(nodes) && nodes[index].Pos() <= pos {
+				// inside a statement or declaration
+				continue
+			}
+			containers[i], indexes[i] = container, index
+		}
+	}
+	return containers, indexes
+}
+
+// insertCommentNodes adds the free-floating comments found by findFloatingComments to the
+// decorated tree as CommentStmt and CommentDecl nodes.
+func (f *fileDecorator) insertCommentNodes() {
+	// Insert in reverse order so the indexes of the earlier comments are still valid.
+	for i := len(f.floating) - 1; i >= 0; i-- {
+		fc := f.floating[i]
+		decs := dst.NodeDecs{Before: dst.EmptyLine, Start: fc.Decs, After: fc.After}
+		switch container := f.Dst.Nodes[fc.Container].(type) {
+		case *dst.BlockStmt:
+			n := &dst.CommentStmt{Decs: dst.CommentStmtDecorations{NodeDecs: decs}}
+			container.List = append(container.List[:fc.Index], append([]dst.Stmt{n}, container.List[fc.Index:]...)...)
+		case *dst.File:
+			n := &dst.CommentDecl{Decs: dst.CommentDeclDecorations{NodeDecs: decs}}
+			container.Decls = append(container.Decls[:fc.Index], append([]dst.Decl{n}, container.Decls[fc.Index:]...)...)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package decorator
+
+import (
+	"bytes"
+	"go/token"
+	"testing"
+
+	"github.com/dave/dst"
+)
+
+func TestCommentNodes(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   string
+		expect string
+	}{
+		{
+			name: "block",
+			code: `package a
+
+func a() {
+
+	// b
+
+	b()
+	// c
+	c()
+
+	// d1
+	// d2
+
+	d()
+
+	// e
+}`,
+			expect: `FuncDecl [Empty line before]
+CommentStmt [Empty line before] [Start "// b"] [Empty line after]
+ExprStmt [Empty line before] [New line after]
+ExprStmt [New line before] [Start "// c"] [Empty line after]
+CommentStmt [Empty line before] [Start "// d1" "// d2"] [Empty line after]
+ExprStmt [Empty line before] [Empty line after]
+CommentStmt [Empty line before] [Start "// e"] [New line after]`,
+		},
+		{
+			name: "file",
+			code: `package a
+
+// a
+
+import "fmt"
+
+/* b1 */
+/* b2 */
+
+// c
+func c() {}
+
+// d
+`,
+			expect: `CommentDecl [Empty line before] [Start "// a"] [Empty line after]
+GenDecl [Empty line before]
+ImportSpec [Empty line after]
+CommentDecl [Empty line before] [Start "/* b1 */" "\n" "/* b2 */"] [Empty line after]
+FuncDecl [Empty line before] [Start "// c"] [Empty line after]
+CommentDecl [Empty line before] [Start "// d"]`,
+		},
+		{
+			name: "not-list-level",
+			code: `package a
+
+func a() {
+	switch {
+	case b:
+
+		// c
+
+		c()
+	}
+	d := []int{
+
+		// e
+
+		1,
+	}
+}`,
+			expect: `FuncDecl [Empty line before]
+SwitchStmt [New line before] [New line after]
+CaseClause [New line before] [New line after]
+ExprStmt [Empty line before] [Start "// c" "\n"]
+AssignStmt [New line before] [New line after]
+BasicLit [Empty line before] [Start "// e" "\n"] [New line after]`,
+		},
+		{
+			name: "nested",
+			code: `package a
+
+// a
+
+func a() {
+
+	// b
+
+	if c {
+
+		// d
+
+		f := func() {
+
+			// e
+
+			e()
+		}
+	}
+
+	// g
+
+}
+
+// h
+`,
+			expect: `CommentDecl [Empty line before] [Start "// a"] [Empty line after]
+FuncDecl [Empty line before] [Empty line after]
+CommentStmt [Empty line before] [Start "// b"] [Empty line after]
+IfStmt [Empty line before] [Empty line after]
+CommentStmt [Empty line before] [Start "// d"] [Empty line after]
+AssignStmt [Empty line before] [New line after]
+CommentStmt [Empty line before] [Start "// e"] [Empty line after]
+ExprStmt [Empty line before] [New line after]
+CommentStmt [Empty line before] [Start "// g"] [Empty line after]
+CommentDecl [Empty line before] [Start "// h"]`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := NewDecorator(token.NewFileSet())
+			d.AttachmentPolicy.CommentNodes = true
+			f, err := d.Parse(test.code)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			buf := &bytes.Buffer{}
+			debug(buf, f)
+			if normalize(test.expect) != normalize(buf.String()) {
+				t.Errorf("diff:\n%s", diff(normalize(test.expect), normalize(buf.String())))
+			}
+
+			buf = &bytes.Buffer{}
+			if err := Fprint(buf, f); err != nil {
+				t.Fatal(err)
+			}
+			compareSrc(t, test.code, buf.String())
+		})
+	}
+}
+
+func TestCommentNodes_Delete(t *testing.T) {
+	code := `package a
+
+func a() {
+	b()
+
+	// c
+
+	d()
+}
+`
+	expect := `package a
+
+func a() {
+
+	// c
+
+	d()
+}
+`
+	d := NewDecorator(token.NewFileSet())
+	d.AttachmentPolicy.CommentNodes = true
+	f, err := d.Parse(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := f.Decls[0].(*dst.FuncDecl).Body
+	if _, ok := body.List[1].(*dst.CommentStmt); !ok {
+		t.Fatalf("expected *dst.CommentStmt, found %T", body.List[1])
+	}
+	body.List = body.List[1:]
+	buf := &bytes.Buffer{}
+	if err := Fprint(buf, f); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != expect {
+		t.Errorf("expected:\n%s\nfound:\n%s", expect, buf.String())
+	}
+}
@@ -59,6 +59,7 @@ func (f *fileDecorator) fragment(node ast.Node) {
 	// If we're decorating a *ast.Package or *ast.File, we add comment and newline fragments
 	if f.Fset != nil {
 		processFile := func(astf *ast.File) {
+			f.files = append(f.files, astf)
 			avoid := map[int]bool{}
 
 			// we will avoid adding a newline decoration that is inside a comment
@@ -208,6 +209,12 @@ func (f *fileDecorator) fragment(node ast.Node) {
 
 func (f *fileDecorator) link() {
 
+	// Pass 0: find free-floating comments and mark them as attached, so they're decorated as
+	// CommentStmt or CommentDecl nodes instead of being attached to a decoration.
+	if f.AttachmentPolicy.CommentNodes {
+		f.findFloatingComments()
+	}
+
 	// Pass 1: associate comment groups with decorations. Sweep up any other comments / new-lines /
 	// empty-lines and associate with the same decoration.
 	for i, frag := range f.fragments {
@@ -292,35 +299,46 @@ func (f *fileDecorator) link() {
 			// 5) After the comment on subsequent lines
 			// 6) Before the comment on previous lines
 			//
-			// We always stop at tokens, strings. If we get to the end without finding a decoration point we panic.
+			// The AttachmentPolicy of the Decorator changes the order of 4-6. We always stop at
+			// tokens, strings. If we get to the end without finding a decoration point we panic.
 
-			var frags []fragment // comment / new-line / empty-line
-			var dec *decorationFragment
-			var found bool
-			var try int
-			for !found {
-				try++
-				switch try {
-				case 1:
-					// Before the comment on the same line (search backwards and stop at any newline)
-					frags, dec, found = f.findDecoration(true, true, i, -1, false)
-				case 2:
-					// After the comment on the same line
-					// After the comment on line+1 (search forwards and stop at any empty line)
-					frags, dec, found = f.findDecoration(false, true, i, 1, false)
-				case 3:
-					// Before the comment on line-1 (search backwards and stop at any empty line)
-					frags, dec, found = f.findDecoration(false, true, i, -1, false)
-				case 4:
+			policy := f.AttachmentPolicy
+
+			// Before the comment on the same line (search backwards and stop at any newline)
+			frags, dec, found := f.findDecoration(true, true, i, -1, false)
+			if found && policy.TrailingContinuations {
+				frags = append(frags, f.findContinuations(i)...)
+			}
+			if !found {
+				// After the comment on the same line
+				// After the comment on line+1 (search forwards and stop at any empty line)
+				frags, dec, found = f.findDecoration(false, true, i, 1, false)
+			}
+			if !found {
+				// Before the comment on line-1 (search backwards and stop at any empty line)
+				aboveFrags, aboveDec, above := f.findDecoration(false, true, i, -1, false)
+				switch {
+				case above && !policy.PreferFollowing:
+					frags, dec, found = aboveFrags, aboveDec, true
+				case !above && policy.FloatSeparated:
+					// Before the comment on line-2 (search backwards)
+					frags, dec, found = f.findDecoration(false, false, i, -1, false)
+				}
+				if !found {
 					// After the comment on line+2 (search forwards)
 					frags, dec, found = f.findDecoration(false, false, i, 1, false)
-				case 5:
-					// After the comment on line-2 (search backwards)
+				}
+				if !found && above {
+					frags, dec, found = aboveFrags, aboveDec, true
+				}
+				if !found {
+					// Before the comment on line-2 (search backwards)
 					frags, dec, found = f.findDecoration(false, false, i, -1, false)
-				default:
-					panic("no decoration found for " + frag.Text)
 				}
 			}
+			if !found {
+				panic("no decoration found for " + frag.Text)
+			}
 			f.attachToDecoration(frags, f.decorations, dec)
 		}
 	}
@@ -463,6 +481,25 @@ func (f *fileDecorator) findDecoration(stopAtNewline, stopAtEmptyLine bool, from
 	return
 }
 
+// findContinuations returns the comments on the lines following the trailing comment at index
+// from that start in the same column, and the newlines before them.
+func (f *fileDecorator) findContinuations(from int) []fragment {
+	column := f.Fset.Position(f.fragments[from].(*commentFragment).Pos).Column
+	var frags []fragment
+	for i := from + 1; i+1 < len(f.fragments); i += 2 {
+		newline, ok := f.fragments[i].(*newlineFragment)
+		if !ok || newline.Empty || newline.Attached != nil {
+			break
+		}
+		comment, ok := f.fragments[i+1].(*commentFragment)
+		if !ok || comment.Attached != nil || f.Fset.Position(comment.Pos).Column != column {
+			break
+		}
+		frags = append(frags, newline, comment)
+	}
+	return frags
+}
+
 func (f *fileDecorator) findNode(from int, direction int) (node ast.Node, dec *decorationFragment, found bool) {
 
 	var name string
@@ -506,6 +543,10 @@ func (f *fileDecorator) findIndentedComments(from int, indents [2]int) (frags [2
 			pastNewline = true
 			frags[stage] = append(frags[stage], current)
 		case *commentFragment:
+			if current.Attached != nil {
+				// free-floating comments (see findFloatingComments) end the search
+				return
+			}
 			if !pastNewline {
 				frags[stage] = append(frags[stage], current)
 				continue
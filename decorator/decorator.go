@@ -59,6 +59,38 @@ type Decorator struct {
 	// is renamed. Setting ResolveLocalPath to true prevents this, so all idents will have the
 	// package path added.
 	ResolveLocalPath bool
+	// Imports is a map of alias -> package path used when parsing snippets with ParseExpr,
+	// ParseStmts, ParseDecls and ParseType. Snippets are parsed as if these packages were imported,
+	// so a Resolver that uses the imports block of the file (e.g. goast.DecoratorResolver) is able
+	// to resolve qualified identifiers.
+	Imports map[string]string
+	// AttachmentPolicy controls which decoration point each comment is attached to. The zero value
+	// uses the default heuristics.
+	AttachmentPolicy AttachmentPolicy
+}
+
+// AttachmentPolicy controls which decoration point each comment is attached to during decoration.
+// By default a comment attaches to a decoration point on the same line (preferring the one before
+// it), then to the next decoration point before an empty line, then to the previous decoration
+// point after an empty line, and finally to any following or preceding decoration point.
+type AttachmentPolicy struct {
+	// PreferFollowing attaches comments on their own line to the next decoration point, even if
+	// there's an empty line between them, in preference to the previous decoration point.
+	PreferFollowing bool
+	// TrailingContinuations attaches comments on the following lines that are aligned with a
+	// trailing comment to the same decoration point as the trailing comment, so a trailing comment
+	// that continues on the following lines stays with the code it follows.
+	TrailingContinuations bool
+	// FloatSeparated attaches comments that have an empty line before and after them to the
+	// preceding decoration point (e.g. the end of the previous statement or the opening brace of
+	// the block), so they stay in place when the following node is moved or deleted.
+	FloatSeparated bool
+	// CommentNodes decorates comments that have an empty line before and after them (or an empty
+	// line before and the closing brace of the block after) as dst.CommentStmt nodes in the
+	// statements of a block, or dst.CommentDecl nodes in the declarations of a file, so they aren't
+	// deleted with a neighbouring node. Comments in the body of a switch or select statement are
+	// attached as usual.
+	CommentNodes bool
 }
 
 // Parse uses parser.ParseFile to parse and decorate a Go source file. The src parameter should
@@ -113,15 +145,16 @@ func (d *Decorator) DecorateFile(f *ast.File) (*dst.File, error) {
 	return file.(*dst.File), nil
 }
 
-// DecorateNode decorates ast.Node and returns dst.Node
+// DecorateNode decorates ast.Node and returns dst.Node. If the Decorator is misconfigured, the
+// error is a *DecorateError.
 func (d *Decorator) DecorateNode(n ast.Node) (dst.Node, error) {
 
 	if d.Resolver == nil && d.Path != "" {
-		panic("Decorator Path should be empty when Resolver is nil")
+		return nil, &DecorateError{Reason: "Decorator Path should be empty when Resolver is nil"}
 	}
 
 	if d.Resolver != nil && d.Path == "" {
-		panic("Decorator Path should be set when Resolver is set")
+		return nil, &DecorateError{Reason: "Decorator Path should be set when Resolver is set"}
 	}
 
 	fd := d.newFileDecorator()
@@ -135,6 +168,7 @@ func (d *Decorator) DecorateNode(n ast.Node) (dst.Node, error) {
 	if err != nil {
 		return nil, err
 	}
+	fd.insertCommentNodes()
 
 	//fmt.Println("\nFragments:")
 	//fd.debug(os.Stdout)
@@ -155,6 +189,39 @@ func (d *Decorator) DecorateNode(n ast.Node) (dst.Node, error) {
 	return out, nil
 }
 
+// Position returns the position of n in the original source. If n was not created by this
+// Decorator (e.g. it was added to the tree after decoration), the zero Position is returned.
+func (d *Decorator) Position(n dst.Node) token.Position {
+	an, ok := d.Ast.Nodes[n]
+	if !ok {
+		return token.Position{}
+	}
+	return d.Fset.Position(an.Pos())
+}
+
+// fork returns a Decorator with the same configuration as d, but with its own Map and Filenames,
+// so it can decorate a file at the same time as d. Use merge to add the results to d.
+func (d *Decorator) fork() *Decorator {
+	return &Decorator{
+		Map:              newMap(),
+		Filenames:        map[*dst.File]string{},
+		Fset:             d.Fset,
+		Resolver:         d.Resolver,
+		Path:             d.Path,
+		ResolveLocalPath: d.ResolveLocalPath,
+		Imports:          d.Imports,
+		AttachmentPolicy: d.AttachmentPolicy,
+	}
+}
+
+// merge adds the Map and Filenames of a fork to d.
+func (d *Decorator) merge(fork *Decorator) {
+	d.Map.merge(fork.Map)
+	for k, v := range fork.Filenames {
+		d.Filenames[k] = v
+	}
+}
+
 func (pd *Decorator) newFileDecorator() *fileDecorator {
 	return &fileDecorator{
 		Decorator:    pd,
@@ -168,13 +235,15 @@ func (pd *Decorator) newFileDecorator() *fileDecorator {
 
 type fileDecorator struct {
 	*Decorator
-	file          *ast.File // file we're decorating in for import name resolution - can be nil if we're just decorating an isolated node
+	file          *ast.File   // file we're decorating in for import name resolution - can be nil if we're just decorating an isolated node
+	files         []*ast.File // files that comment and newline fragments were added for
 	cursor        int
 	fragments     []fragment
 	startIndents  map[ast.Node]int
 	endIndents    map[ast.Node]int
 	before, after map[ast.Node]dst.SpaceType
 	decorations   map[ast.Node]map[string][]string
+	floating      []*floatingComment // free-floating comments, see AttachmentPolicy.CommentNodes
 }
 
 // We never need to resolve idents that are in these fields (decorateSelectorExpr will override
@@ -313,7 +382,7 @@ func (f *fileDecorator) decorateSelectorExpr(parent ast.Node, parentName, parent
 func (f *fileDecorator) resolvePath(force bool, parent ast.Node, parentName, parentField, parentFieldType string, id *ast.Ident) (string, error) {
 
 	if f.Resolver == nil {
-		panic("resolvePath needs a Resolver")
+		return "", &DecorateError{Node: id, Reason: "resolvePath needs a Resolver"}
 	}
 
 	if !force {
@@ -321,7 +390,7 @@ func (f *fileDecorator) resolvePath(force bool, parent ast.Node, parentName, par
 			return "", nil
 		}
 		if parentFieldType != "Expr" {
-			panic(fmt.Sprintf("decorateIdent: unsupported parentName %s, parentField %s, parentFieldType %s", parentName, parentField, parentFieldType))
+			return "", &DecorateError{Node: id, Reason: fmt.Sprintf("decorateIdent: unsupported parentName %s, parentField %s, parentFieldType %s", parentName, parentField, parentFieldType)}
 		}
 	}
 
@@ -409,7 +478,7 @@ func (f *fileDecorator) decorateObject(o *ast.Object) (*dst.Object, error) {
 		out.Decl = n
 	case nil:
 	default:
-		panic(fmt.Sprintf("o.Decl is %T", o.Data))
+		return nil, &DecorateError{Reason: fmt.Sprintf("unsupported Object.Decl type %T", o.Decl)}
 	}
 
 	switch data := o.Data.(type) {
@@ -429,7 +498,7 @@ func (f *fileDecorator) decorateObject(o *ast.Object) (*dst.Object, error) {
 		out.Data = n
 	case nil:
 	default:
-		panic(fmt.Sprintf("o.Data is %T", o.Data))
+		return nil, &DecorateError{Reason: fmt.Sprintf("unsupported Object.Data type %T", o.Data)}
 	}
 
 	return out, nil
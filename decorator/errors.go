@@ -0,0 +1,52 @@
+package decorator
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/dave/dst"
+)
+
+// RestoreError is returned by the Restorer when a tree can't be restored, e.g. the tree contains
+// the same node twice, an Ident has a Path in a position that can't be a qualified identifier, or
+// the Restorer is misconfigured. Node is the node that caused the error, or nil if the error is
+// not caused by a specific node.
+type RestoreError struct {
+	Node   dst.Node
+	Reason string
+}
+
+func (e *RestoreError) Error() string {
+	if e.Node == nil {
+		return e.Reason
+	}
+	return fmt.Sprintf("%s (%T)", e.Reason, e.Node)
+}
+
+// DecorateError is returned by the Decorator when a node can't be decorated, e.g. the Decorator
+// is misconfigured. Node is the node that caused the error, or nil if the error is not caused by
+// a specific node.
+type DecorateError struct {
+	Node   ast.Node
+	Reason string
+}
+
+func (e *DecorateError) Error() string {
+	if e.Node == nil {
+		return e.Reason
+	}
+	return fmt.Sprintf("%s (%T)", e.Reason, e.Node)
+}
+
+// recoverRestoreError is deferred by the exported restore methods. The restorer is recursive and
+// doesn't return errors, so it panics with a *RestoreError, which is converted back to an error
+// here. Any other panic is re-raised.
+func recoverRestoreError(err *error) {
+	if r := recover(); r != nil {
+		e, ok := r.(*RestoreError)
+		if !ok {
+			panic(r)
+		}
+		*err = e
+	}
+}
@@ -0,0 +1,176 @@
+package decorator
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator/resolver/goast"
+	"github.com/dave/dst/decorator/resolver/guess"
+)
+
+func TestRestoreError(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		mutate   func(f *dst.File) dst.Node // returns the node expected in the error
+		restorer func() *Restorer
+		expect   string
+	}{
+		{
+			name: "path-without-resolver",
+			code: "package a",
+			restorer: func() *Restorer {
+				r := NewRestorer()
+				r.Path = "a"
+				return r
+			},
+			expect: "Restorer Path should be empty when Resolver is nil",
+		},
+		{
+			name: "duplicate-node",
+			code: "package a\n\nvar a = b + c\n",
+			mutate: func(f *dst.File) dst.Node {
+				e := f.Decls[0].(*dst.GenDecl).Specs[0].(*dst.ValueSpec).Values[0].(*dst.BinaryExpr)
+				e.Y = e.X
+				return e.X
+			},
+			expect: "duplicate node (*dst.Ident)",
+		},
+		{
+			name: "ident-path-without-resolver",
+			code: "package a\n\nvar a = b\n",
+			mutate: func(f *dst.File) dst.Node {
+				id := f.Decls[0].(*dst.GenDecl).Specs[0].(*dst.ValueSpec).Values[0].(*dst.Ident)
+				id.Path = "fmt"
+				return id
+			},
+		},
+		{
+			name: "illegal-ident-path",
+			code: "package a\n\nfunc a() {}\n",
+			mutate: func(f *dst.File) dst.Node {
+				id := f.Decls[0].(*dst.FuncDecl).Name
+				id.Path = "fmt"
+				return id
+			},
+			restorer: func() *Restorer {
+				return NewRestorerWithImports("a", guess.New())
+			},
+			expect: "Path fmt set on illegal Ident a: parentName FuncDecl, parentField Name, parentFieldType Ident (*dst.Ident)",
+		},
+		{
+			name: "invalid-import-path",
+			code: "package a\n\nimport \"fmt\"\n",
+			mutate: func(f *dst.File) dst.Node {
+				spec := f.Decls[0].(*dst.GenDecl).Specs[0].(*dst.ImportSpec)
+				spec.Path.Value = "fmt"
+				return spec
+			},
+			restorer: func() *Restorer {
+				return NewRestorerWithImports("a", guess.New())
+			},
+			expect: "invalid import path fmt (*dst.ImportSpec)",
+		},
+		{
+			name: "comment-stmt-outside-list",
+			code: "package a\n\nfunc a() {\n\tif b {\n\t}\n}\n",
+			mutate: func(f *dst.File) dst.Node {
+				c := &dst.CommentStmt{}
+				c.Decs.Start.Append("// c")
+				f.Decls[0].(*dst.FuncDecl).Body.List[0].(*dst.IfStmt).Init = c
+				return c
+			},
+			expect: "CommentStmt outside a list of statements (*dst.CommentStmt)",
+		},
+		{
+			name: "comment-stmt-else",
+			code: "package a\n\nfunc a() {\n\tif b {\n\t}\n}\n",
+			mutate: func(f *dst.File) dst.Node {
+				c := &dst.CommentStmt{}
+				f.Decls[0].(*dst.FuncDecl).Body.List[0].(*dst.IfStmt).Else = c
+				return c
+			},
+			expect: "CommentStmt outside a list of statements (*dst.CommentStmt)",
+		},
+		{
+			name: "comment-decl-outside-file",
+			code: "package a\n\nfunc a() {\n\tvar b int\n}\n",
+			mutate: func(f *dst.File) dst.Node {
+				c := &dst.CommentDecl{}
+				f.Decls[0].(*dst.FuncDecl).Body.List[0].(*dst.DeclStmt).Decl = c
+				return c
+			},
+			expect: "CommentDecl outside File.Decls (*dst.CommentDecl)",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := Parse(test.code)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var node dst.Node
+			if test.mutate != nil {
+				node = test.mutate(f)
+			}
+			r := NewRestorer()
+			if test.restorer != nil {
+				r = test.restorer()
+			}
+			err = r.Fprint(&bytes.Buffer{}, f)
+			var re *RestoreError
+			if !errors.As(err, &re) {
+				t.Fatalf("expected *RestoreError, found %#v", err)
+			}
+			if re.Node != node {
+				t.Errorf("expected node %#v, found %#v", node, re.Node)
+			}
+			if test.expect != "" && re.Error() != test.expect {
+				t.Errorf("expected %q, found %q", test.expect, re.Error())
+			}
+		})
+	}
+}
+
+func TestRestoreErrorNode(t *testing.T) {
+	e, err := ParseExpr("a + b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := e.(*dst.BinaryExpr)
+	b.Y = b.X
+	_, err = NewRestorer().RestoreNode(b)
+	var re *RestoreError
+	if !errors.As(err, &re) || re.Node != b.X {
+		t.Fatalf("expected *RestoreError for %#v, found %#v", b.X, err)
+	}
+}
+
+func TestRestoreErrorCommentNode(t *testing.T) {
+	c := &dst.CommentDecl{}
+	c.Decs.Start.Append("// a")
+	n, err := NewRestorer().RestoreNode(c)
+	var re *RestoreError
+	if !errors.As(err, &re) || re.Node != c || n != nil {
+		t.Fatalf("expected *RestoreError for %#v, found %#v, %#v", c, n, err)
+	}
+}
+
+func TestDecorateError(t *testing.T) {
+	d := NewDecorator(nil)
+	d.Path = "a"
+	_, err := d.Parse("package a")
+	var de *DecorateError
+	if !errors.As(err, &de) {
+		t.Fatalf("expected *DecorateError, found %#v", err)
+	}
+
+	d = NewDecorator(nil)
+	d.Resolver = goast.New()
+	_, err = d.Parse("package a")
+	if !errors.As(err, &de) {
+		t.Fatalf("expected *DecorateError, found %#v", err)
+	}
+}
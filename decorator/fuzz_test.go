@@ -0,0 +1,174 @@
+package decorator
+
+import (
+	"bytes"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/dave/dst"
+)
+
+// The seed corpus in testdata/fuzz was created from a selection of files in the standard library
+// (Copyright The Go Authors, BSD license).
+
+// FuzzRoundTrip checks that decorating and restoring any gofmt formatted source gives the same
+// output as gofmt.
+func FuzzRoundTrip(f *testing.F) {
+	f.Add([]byte("package a"))
+	f.Add([]byte("package a\n\n// a\nfunc a() { /* b */ }\n"))
+	f.Fuzz(func(t *testing.T, src []byte) {
+		if _, err := parser.ParseFile(token.NewFileSet(), "", src, parser.ParseComments); err != nil {
+			// format.Source also accepts partial source files, but only complete files are decorated
+			return
+		}
+		expect, err := format.Source(src)
+		if err != nil {
+			return
+		}
+		if again, err := format.Source(expect); err != nil || !bytes.Equal(again, expect) {
+			// gofmt isn't idempotent for this source
+			return
+		}
+		file, err := Parse(expect)
+		if err != nil {
+			t.Fatalf("source parsed by gofmt can't be decorated: %v", err)
+		}
+		buf := &bytes.Buffer{}
+		if err := NewRestorer().Fprint(buf, file); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != string(expect) {
+			t.Errorf("diff:\n%s", diff(string(expect), buf.String()))
+		}
+	})
+}
+
+// FuzzMoveComments moves comments to random decoration points and checks that the restored source
+// is valid and contains all the comments.
+func FuzzMoveComments(f *testing.F) {
+	f.Add([]byte("package a\n\n// a\nfunc a(b int) {\n\tc(b) // c\n}\n"), int64(1))
+	f.Add([]byte("package a\n\nvar a = []int{ /* a */ 1, 2 /* b */}\n"), int64(2))
+	f.Fuzz(func(t *testing.T, src []byte, seed int64) {
+		if _, err := parser.ParseFile(token.NewFileSet(), "", src, parser.ParseComments); err != nil {
+			return
+		}
+		file, err := Parse(src)
+		if err != nil {
+			t.Fatalf("source parsed by go/parser can't be decorated: %v", err)
+		}
+
+		var points []*dst.Decorations
+		dst.Inspect(file, func(n dst.Node) bool {
+			if n != nil {
+				points = append(points, decorationPoints(n)...)
+			}
+			return true
+		})
+
+		// Remove some comments from their decoration points and append them to random points. Line
+		// comments are converted to block comments so they can be moved into the middle of a line.
+		rnd := rand.New(rand.NewSource(seed))
+		for _, p := range points {
+			var keep dst.Decorations
+			for _, d := range *p {
+				if !isComment(d) || strings.HasPrefix(d, "//") && strings.Contains(d, "*/") || rnd.Intn(2) == 0 {
+					keep = append(keep, d)
+					continue
+				}
+				if strings.HasPrefix(d, "//") {
+					d = "/*" + d[2:] + "*/"
+				}
+				target := points[rnd.Intn(len(points))]
+				if target == p {
+					keep = append(keep, d)
+					continue
+				}
+				target.Append(d)
+			}
+			*p = keep
+		}
+		expect := commentTexts(file)
+
+		buf := &bytes.Buffer{}
+		if err := NewRestorer().Fprint(buf, file); err != nil {
+			t.Fatal(err)
+		}
+		restored, err := parser.ParseFile(token.NewFileSet(), "", buf.Bytes(), parser.ParseComments)
+		if err != nil {
+			t.Fatalf("restored source is invalid: %v\n%s", err, buf.String())
+		}
+		var found []string
+		for _, cg := range restored.Comments {
+			for _, c := range cg.List {
+				if text := commentText(c.Text); text != "" {
+					found = append(found, text)
+				}
+			}
+		}
+		sort.Strings(found)
+		if strings.Join(expect, "\n") != strings.Join(found, "\n") {
+			t.Errorf("comments lost:\n%s", diff(strings.Join(expect, "\n"), strings.Join(found, "\n")))
+		}
+	})
+}
+
+// decorationPoints returns pointers to the decoration points of a node.
+func decorationPoints(n dst.Node) []*dst.Decorations {
+	var out []*dst.Decorations
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		for i := 0; i < v.NumField(); i++ {
+			switch field := v.Field(i); field.Type() {
+			case reflect.TypeOf(dst.Decorations{}):
+				out = append(out, field.Addr().Interface().(*dst.Decorations))
+			case reflect.TypeOf(dst.NodeDecs{}):
+				walk(field)
+			}
+		}
+	}
+	if decs := reflect.ValueOf(n).Elem().FieldByName("Decs"); decs.IsValid() {
+		walk(decs)
+	}
+	return out
+}
+
+// commentTexts returns the sorted text of all comments in the file.
+func commentTexts(file *dst.File) []string {
+	var out []string
+	dst.Inspect(file, func(n dst.Node) bool {
+		if n == nil {
+			return false
+		}
+		for _, p := range decorationPoints(n) {
+			for _, d := range *p {
+				if text := commentText(d); isComment(d) && text != "" {
+					out = append(out, text)
+				}
+			}
+		}
+		return true
+	})
+	sort.Strings(out)
+	return out
+}
+
+func isComment(d string) bool {
+	return strings.HasPrefix(d, "//") || strings.HasPrefix(d, "/*")
+}
+
+// commentText strips the comment markers so a line comment matches the equivalent block comment.
+// Whitespace is normalized because gofmt reformats doc comments.
+func commentText(d string) string {
+	if strings.HasPrefix(d, "//") {
+		d = d[2:]
+	} else {
+		d = strings.TrimSuffix(strings.TrimPrefix(d, "/*"), "*/")
+	}
+	return strings.Join(strings.Fields(d), " ")
+}
@@ -39,6 +39,26 @@ func DecorateFile(fset *token.FileSet, f *ast.File) (*dst.File, error) {
 	return NewDecorator(fset).DecorateFile(f)
 }
 
+// ParseExpr parses and decorates a Go expression. See Decorator.ParseExpr.
+func ParseExpr(src string) (dst.Expr, error) {
+	return NewDecorator(token.NewFileSet()).ParseExpr(src)
+}
+
+// ParseType parses and decorates a Go type expression. See Decorator.ParseType.
+func ParseType(src string) (dst.Expr, error) {
+	return NewDecorator(token.NewFileSet()).ParseType(src)
+}
+
+// ParseStmts parses and decorates a list of Go statements. See Decorator.ParseStmts.
+func ParseStmts(src string) ([]dst.Stmt, error) {
+	return NewDecorator(token.NewFileSet()).ParseStmts(src)
+}
+
+// ParseDecls parses and decorates a list of Go declarations. See Decorator.ParseDecls.
+func ParseDecls(src string) ([]dst.Decl, error) {
+	return NewDecorator(token.NewFileSet()).ParseDecls(src)
+}
+
 // Print uses format.Node to print a *dst.File to stdout
 func Print(f *dst.File) error {
 	return Fprint(os.Stdout, f)
@@ -53,6 +73,12 @@ func Fprint(w io.Writer, f *dst.File) error {
 	return format.Node(w, fset, af)
 }
 
+// FprintNode uses format.Node to print any dst.Node (e.g. an expression, statement or declaration)
+// with its decorations to a writer
+func FprintNode(w io.Writer, n dst.Node) error {
+	return NewRestorer().FprintNode(w, n)
+}
+
 // RestoreFile restores a *dst.File to a *token.FileSet and a *ast.File
 func RestoreFile(file *dst.File) (*token.FileSet, *ast.File, error) {
 	r := NewRestorer()
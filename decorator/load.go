@@ -3,43 +3,94 @@ package decorator
 import (
 	"bytes"
 	"errors"
+	"go/ast"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
 
 	"github.com/dave/dst"
 	"github.com/dave/dst/decorator/resolver"
 	"github.com/dave/dst/decorator/resolver/gopackages"
+	"github.com/dave/dst/dstutil"
 	"golang.org/x/tools/go/packages"
 )
 
+// Load loads and decorates packages. The files are decorated one at a time: use LoadWithConfig to
+// decorate them in parallel.
 func Load(cfg *packages.Config, patterns ...string) ([]*Package, error) {
+	return LoadWithConfig(&LoadConfig{Packages: cfg}, patterns...)
+}
+
+// LoadConfig configures LoadWithConfig.
+type LoadConfig struct {
+	// Packages is the config used by packages.Load. If nil, packages.LoadSyntax mode is used.
+	Packages *packages.Config
+	// Parallel is the maximum number of files that are decorated at the same time (e.g.
+	// runtime.GOMAXPROCS(0)). It is also copied to the Parallel field of the loaded packages, so it
+	// limits the number of files restored at the same time by Save. If zero, files are processed
+	// one at a time. When Parallel is more than 1, the resolvers are called from multiple
+	// goroutines, so the Resolver of each package Decorator and the resolver passed to
+	// SaveWithResolver must be safe for concurrent use.
+	Parallel int
+	// If Lazy is set, only the packages matching the patterns are decorated. Their dependencies
+	// (in Imports) aren't decorated until Package.Decorate is called, so tools that only rewrite
+	// the root packages don't pay to decorate the dependencies (e.g. when the NeedDeps mode is
+	// used).
+	Lazy bool
+	// If Track is set, each file is tracked with dstutil.Track after it is decorated and after it
+	// is saved, and Save skips the files that haven't changed. See Package.Changes.
+	Track bool
+}
+
+// LoadWithConfig loads and decorates packages. Each file is decorated separately, so with
+// LoadConfig.Parallel set the files of all the packages are decorated in parallel, and the results
+// are merged into the Decorator of each package. See LoadConfig.Lazy to skip decorating dependencies.
+func LoadWithConfig(cfg *LoadConfig, patterns ...string) ([]*Package, error) {
 
 	if cfg == nil {
-		cfg = &packages.Config{Mode: packages.LoadSyntax}
+		cfg = &LoadConfig{}
 	}
 
-	if cfg.Mode&packages.NeedSyntax == 0 {
+	pcfg := cfg.Packages
+	if pcfg == nil {
+		pcfg = &packages.Config{Mode: packages.LoadSyntax}
+	}
+
+	if pcfg.Mode&packages.NeedSyntax == 0 {
 		return nil, errors.New("config mode should include NeedSyntax")
 	}
 
-	pkgs, err := packages.Load(cfg, patterns...)
+	parallel := cfg.Parallel
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	pkgs, err := packages.Load(pcfg, patterns...)
 	if err != nil {
 		return nil, err
 	}
 
+	var all []*Package
 	dpkgs := map[*packages.Package]*Package{}
 
-	var convert func(p *packages.Package) (*Package, error)
-	convert = func(pkg *packages.Package) (*Package, error) {
+	var convert func(p *packages.Package) *Package
+	convert = func(pkg *packages.Package) *Package {
 		if dp, ok := dpkgs[pkg]; ok {
-			return dp, nil
+			return dp
 		}
 		p := &Package{
-			Package: pkg,
-			Imports: map[string]*Package{},
+			Package:  pkg,
+			Imports:  map[string]*Package{},
+			Parallel: parallel,
+		}
+		if cfg.Track {
+			p.trackers = map[*dst.File]*dstutil.Tracker{}
 		}
 		dpkgs[pkg] = p
+		all = append(all, p)
 		if len(pkg.Syntax) > 0 {
 
 			// Only decorate files in the GoFiles list. Syntax also has preprocessed cgo files which
@@ -55,63 +106,242 @@ func Load(cfg *packages.Config, patterns ...string) ([]*Package, error) {
 				if !goFiles[fpath] {
 					continue
 				}
-				file, err := p.Decorator.DecorateFile(f)
-				if err != nil {
-					return nil, err
-				}
-				p.Syntax = append(p.Syntax, file)
+				p.files = append(p.files, f)
 			}
 
 			dir, _ := filepath.Split(pkg.Fset.File(pkg.Syntax[0].Pos()).Name())
 			p.Dir = dir
 
 			for path, imp := range pkg.Imports {
-				dimp, err := convert(imp)
-				if err != nil {
-					return nil, err
-				}
-				p.Imports[path] = dimp
+				p.Imports[path] = convert(imp)
 			}
 		}
-		return p, nil
+		return p
 	}
 
 	var out []*Package
 	for _, pkg := range pkgs {
-		p, err := convert(pkg)
-		if err != nil {
-			return nil, err
-		}
-		out = append(out, p)
+		out = append(out, convert(pkg))
+	}
+
+	decorate := all
+	if cfg.Lazy {
+		decorate = out
+	}
+	if err := decoratePackages(parallel, decorate); err != nil {
+		return nil, err
 	}
 
 	return out, nil
 }
 
+// decoratePackages decorates the files of the packages that haven't been decorated yet. The
+// files of all the packages are decorated in parallel. A package is only marked as decorated when
+// all of its files have been decorated. When decorating one file at a time the package Decorator
+// is updated in place, so if a file fails, the error is recorded and returned by later calls
+// instead of decorating the package again.
+func decoratePackages(parallel int, pkgs []*Package) error {
+
+	type job struct {
+		pkg  *Package
+		ast  *ast.File
+		dec  *Decorator
+		file *dst.File
+		err  error
+	}
+	var jobs []*job
+
+	// Lock the packages in order of ID so concurrent calls with overlapping packages can't
+	// deadlock. The packages stay locked until they have been decorated.
+	locked := map[*Package]bool{}
+	var ordered []*Package
+	for _, p := range pkgs {
+		if !locked[p] {
+			locked[p] = true
+			ordered = append(ordered, p)
+		}
+	}
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].ID < ordered[j].ID })
+	for _, p := range ordered {
+		p.m.Lock()
+	}
+	defer func() {
+		for _, p := range ordered {
+			p.m.Unlock()
+		}
+	}()
+
+	var pending []*Package
+	for _, p := range ordered {
+		if p.err != nil {
+			return p.err
+		}
+		if p.decorated {
+			continue
+		}
+		pending = append(pending, p)
+		for _, f := range p.files {
+			jobs = append(jobs, &job{pkg: p, ast: f})
+		}
+	}
+
+	// The Map and Filenames of a Decorator aren't thread safe, so when decorating in parallel each
+	// file is decorated by a fork of the package Decorator, which is merged back afterwards.
+	err := runParallel(parallel, len(jobs), func(i int) error {
+		j := jobs[i]
+		j.dec = j.pkg.Decorator
+		if parallel > 1 {
+			j.dec = j.dec.fork()
+		}
+		j.file, j.err = j.dec.DecorateFile(j.ast)
+		return j.err
+	})
+
+	// find the packages that have a file that failed or wasn't decorated
+	incomplete := map[*Package]bool{}
+	for _, j := range jobs {
+		if j.file == nil {
+			incomplete[j.pkg] = true
+		}
+		if j.err != nil && j.dec == j.pkg.Decorator {
+			// the Decorator has been partially updated
+			j.pkg.err = j.err
+		}
+	}
+
+	for _, j := range jobs {
+		if incomplete[j.pkg] {
+			continue
+		}
+		if j.dec != j.pkg.Decorator {
+			j.pkg.Decorator.merge(j.dec)
+		}
+		j.pkg.Syntax = append(j.pkg.Syntax, j.file)
+		if j.pkg.trackers != nil {
+			j.pkg.trackers[j.file] = dstutil.Track(j.file)
+		}
+	}
+	for _, p := range pending {
+		if !incomplete[p] {
+			p.decorated = true
+		}
+	}
+
+	return err
+}
+
 type Package struct {
 	*packages.Package
 	Dir       string
 	Decorator *Decorator
 	Imports   map[string]*Package
 	Syntax    []*dst.File
+	Parallel  int  // The maximum number of files decorated by Decorate or restored by Save at the same time. If < 2, files are processed one at a time. See LoadConfig.Parallel for the resolvers.
+	Minimal   bool // If Minimal is set, Save only replaces the top level declarations that have changed, leaving the rest of each file byte for byte as it was. See Restorer.FprintMinimal.
+
+	m         sync.Mutex
+	files     []*ast.File // the files to decorate
+	decorated bool
+	err       error                          // the error from decorating the files if the Decorator was partially updated
+	trackers  map[*dst.File]*dstutil.Tracker // if LoadConfig.Track was set, the state of each file after it was decorated or last saved
+}
+
+// Decorate decorates the files of the package if they haven't been decorated yet. When packages
+// are loaded with LoadConfig.Lazy set, only the root packages are decorated, so Decorate must be
+// called before using the Syntax or Decorator of their dependencies. Decorate is safe to call
+// from multiple goroutines. If decorating a file fails after the Decorator has been partially
+// updated, the same error is returned by later calls.
+func (p *Package) Decorate() error {
+	return decoratePackages(p.Parallel, []*Package{p})
+}
+
+// Changes returns the changes made to a file since it was decorated or last saved, or nil if the
+// package was loaded without LoadConfig.Track.
+func (p *Package) Changes(f *dst.File) *dstutil.Changes {
+	p.m.Lock()
+	t, ok := p.trackers[f]
+	p.m.Unlock()
+	if !ok {
+		return nil
+	}
+	return t.Changes()
 }
 
 func (p *Package) Save() error {
 	return p.save(gopackages.New(p.Dir), ioutil.WriteFile)
 }
 
+// SaveWithResolver restores and saves the files of the package, using resolver to find the names
+// of imported packages. When Parallel is more than 1, resolver is called from multiple goroutines,
+// so it must be safe for concurrent use.
 func (p *Package) SaveWithResolver(resolver resolver.RestorerResolver) error {
 	return p.save(resolver, ioutil.WriteFile)
 }
 
 func (p *Package) save(resolver resolver.RestorerResolver, writeFile func(filename string, data []byte, perm os.FileMode) error) error {
-	r := NewRestorerWithImports(p.PkgPath, resolver)
-	for _, file := range p.Syntax {
+	return runParallel(p.Parallel, len(p.Syntax), func(i int) error {
+		file := p.Syntax[i]
+		if c := p.Changes(file); c != nil && c.Empty() {
+			return nil
+		}
+		fpath := p.Decorator.Filenames[file]
+		r := NewRestorerWithImports(p.PkgPath, resolver)
 		buf := &bytes.Buffer{}
-		if err := r.Fprint(buf, file); err != nil {
+		if p.Minimal {
+			src, err := ioutil.ReadFile(fpath)
+			if err != nil {
+				return err
+			}
+			if err := r.FprintMinimal(buf, p.Decorator, file, src); err != nil {
+				return err
+			}
+		} else if err := r.Fprint(buf, file); err != nil {
+			return err
+		}
+		if err := writeFile(fpath, buf.Bytes(), 0666); err != nil {
 			return err
 		}
-		if err := writeFile(p.Decorator.Filenames[file], buf.Bytes(), 0666); err != nil {
+		// track the saved state, so the file isn't written again until it changes
+		p.m.Lock()
+		defer p.m.Unlock()
+		if p.trackers != nil {
+			p.trackers[file] = dstutil.Track(file)
+		}
+		return nil
+	})
+}
+
+// runParallel calls f for each index from 0 to n-1, with at most limit calls running at the same
+// time. After a call fails no more calls are started, and the error with the lowest index is
+// returned. With a limit of 1 the calls are made in order, stopping at the first error.
+func runParallel(limit, n int, f func(i int) error) error {
+	if limit < 1 {
+		limit = 1
+	}
+	errs := make([]error, n)
+	sem := make(chan struct{}, limit)
+	var failed int32
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		sem <- struct{}{}
+		if atomic.LoadInt32(&failed) != 0 {
+			<-sem
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if errs[i] = f(i); errs[i] != nil {
+				atomic.StoreInt32(&failed, 1)
+			}
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
 			return err
 		}
 	}
@@ -1,10 +1,20 @@
 package decorator
 
 import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
 
 	"github.com/dave/dst"
+	"github.com/dave/dst/decorator/resolver/guess"
 	"github.com/dave/dst/decorator/resolver/simple"
+	"github.com/dave/dst/dstutil"
 	"golang.org/x/tools/go/packages"
 )
 
@@ -103,3 +113,340 @@ func TestPackage_SaveWithResolver(t *testing.T) {
 	}
 	compareDir(t, dir, expect)
 }
+
+func TestLoadWithConfig(t *testing.T) {
+	code := map[string]string{
+		"a.go": `package a
+
+			import "root/b"
+
+			func a() {
+				b.B()
+			}
+		`,
+		"a2.go": `package a
+
+			func a2() {
+				a()
+			}
+		`,
+		"b/b.go": `package b
+
+			func B() {
+				b()
+			}
+		`,
+		"b/b2.go": `package b
+
+			func b() {}
+		`,
+		"go.mod": "module root\n\ngo 1.14",
+	}
+	expect := map[string]string{
+		"a.go": `package a
+
+			import "root/b"
+
+			func a() {
+				b.B() // a
+			}
+		`,
+		"a2.go": `package a
+
+			func a2() {
+				a() // a
+			}
+		`,
+		"b/b.go": `package b
+
+			func B() {
+				b() // a
+			}
+		`,
+		"b/b2.go": `package b
+
+			func b() {}
+		`,
+		"go.mod": "module root\n\ngo 1.14",
+	}
+	dir, err := tempDir(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &LoadConfig{
+		Packages: &packages.Config{
+			Mode: packages.LoadAllSyntax,
+			Dir:  dir,
+		},
+		Parallel: 4,
+	}
+	pkgs, err := LoadWithConfig(cfg, "root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	all := []*Package{pkgs[0], pkgs[0].Imports["root/b"]}
+	for _, pkg := range all {
+		if len(pkg.Syntax) != 2 {
+			t.Fatalf("expected 2 files in %s, found %d", pkg.PkgPath, len(pkg.Syntax))
+		}
+		for i, f := range pkg.Syntax {
+			if pkg.Decorator.Filenames[f] != pkg.GoFiles[i] {
+				t.Errorf("expected %s, found %s", pkg.GoFiles[i], pkg.Decorator.Filenames[f])
+			}
+			if pkg.Decorator.Dst.Nodes[pkg.Package.Syntax[i]] != f {
+				t.Errorf("file %s missing from Decorator map", pkg.GoFiles[i])
+			}
+			dst.Inspect(f, func(n dst.Node) bool {
+				switch n := n.(type) {
+				case *dst.CallExpr:
+					n.Decorations().End.Append("// a")
+				case *dst.Ident:
+					if n.Name == "B" && n.Path != "root/b" && pkg.PkgPath == "root" {
+						t.Errorf("expected B to have path root/b, found %q", n.Path)
+					}
+				}
+				return true
+			})
+		}
+		if err := pkg.SaveWithResolver(guess.New()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	compareDir(t, dir, expect)
+}
+
+func TestRunParallel(t *testing.T) {
+	var calls int32
+	err := runParallel(3, 100, func(i int) error {
+		atomic.AddInt32(&calls, 1)
+		if i == 10 || i == 20 {
+			return fmt.Errorf("error %d", i)
+		}
+		return nil
+	})
+	if err == nil || err.Error() != "error 10" {
+		t.Fatalf("expected error 10, found %v", err)
+	}
+	if calls < 11 || calls >= 100 {
+		t.Errorf("expected calls to stop after an error, found %d calls", calls)
+	}
+
+	var order []int
+	err = runParallel(1, 5, func(i int) error {
+		order = append(order, i)
+		if i == 2 {
+			return fmt.Errorf("error %d", i)
+		}
+		return nil
+	})
+	if err == nil || fmt.Sprint(order) != "[0 1 2]" {
+		t.Fatalf("expected sequential calls stopping at the error, found %v %v", order, err)
+	}
+}
+
+type failResolver struct{}
+
+func (failResolver) ResolveIdent(file *ast.File, parent ast.Node, parentField string, id *ast.Ident) (string, error) {
+	if id.Name == "fail" {
+		return "", errors.New("fail")
+	}
+	return "", nil
+}
+
+func TestDecoratePackages_Error(t *testing.T) {
+	for _, parallel := range []int{1, 4} {
+		t.Run(fmt.Sprint(parallel), func(t *testing.T) {
+			fset := token.NewFileSet()
+			parse := func(src string) *ast.File {
+				f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return f
+			}
+			newPackage := func(id string, files ...*ast.File) *Package {
+				d := NewDecoratorWithImports(fset, id, failResolver{})
+				return &Package{Package: &packages.Package{ID: id}, Decorator: d, files: files, Parallel: parallel}
+			}
+			a := newPackage("a", parse("package a\n\nvar a = 1"))
+			b := newPackage("b", parse("package b\n\nvar b = 1"), parse("package b\n\nvar c = fail"))
+
+			// b is before a, so the locks aren't taken in the order of the arguments
+			err := decoratePackages(parallel, []*Package{b, a, b})
+			if err == nil || err.Error() != "fail" {
+				t.Fatalf("expected fail error, found %v", err)
+			}
+			if !a.decorated || len(a.Syntax) != 1 {
+				t.Fatalf("expected a to be decorated, found %v %d", a.decorated, len(a.Syntax))
+			}
+			if b.decorated || len(b.Syntax) != 0 {
+				t.Fatalf("expected b not to be decorated, found %v %d", b.decorated, len(b.Syntax))
+			}
+
+			// the packages are unlocked after an error
+			if err := a.Decorate(); err != nil {
+				t.Fatal(err)
+			}
+			if len(a.Syntax) != 1 {
+				t.Fatalf("expected a to be decorated once, found %d files", len(a.Syntax))
+			}
+
+			// decorating one file at a time updates the Decorator in place, so the error is
+			// recorded. Decorating in parallel uses forks, so b is decorated again.
+			err = b.Decorate()
+			if err == nil || err.Error() != "fail" {
+				t.Fatalf("expected fail error, found %v", err)
+			}
+			if (b.err != nil) != (parallel == 1) {
+				t.Fatalf("expected recorded error %v, found %v", parallel == 1, b.err)
+			}
+			if len(b.Decorator.Filenames) > 1 {
+				t.Fatalf("expected at most one file in b.Decorator, found %d", len(b.Decorator.Filenames))
+			}
+		})
+	}
+}
+
+func TestPackage_SaveTrack(t *testing.T) {
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range []string{"a.go", "b.go"} {
+		f, err := parser.ParseFile(fset, name, "package a\n\nfunc "+name[:1]+"() {}\n", parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+	}
+	p := &Package{
+		Package:   &packages.Package{ID: "a", PkgPath: "a"},
+		Decorator: NewDecorator(fset),
+		trackers:  map[*dst.File]*dstutil.Tracker{},
+		files:     files,
+	}
+	if err := p.Decorate(); err != nil {
+		t.Fatal(err)
+	}
+	var written []string
+	save := func() {
+		written = nil
+		err := p.save(guess.New(), func(filename string, data []byte, perm os.FileMode) error {
+			written = append(written, filename)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	p.Syntax[0].Decls[0].(*dst.FuncDecl).Name.Name = "c"
+	save()
+	if fmt.Sprint(written) != "[a.go]" {
+		t.Fatalf("expected a.go to be written, found %v", written)
+	}
+	if c := p.Changes(p.Syntax[0]); c == nil || !c.Empty() {
+		t.Fatalf("expected no changes after saving, found %v", c)
+	}
+	save()
+	if len(written) != 0 {
+		t.Fatalf("expected no files to be written, found %v", written)
+	}
+	p.Syntax[1].Decls[0].(*dst.FuncDecl).Name.Name = "d"
+	save()
+	if fmt.Sprint(written) != "[b.go]" {
+		t.Fatalf("expected b.go to be written, found %v", written)
+	}
+}
+
+func TestLoadWithConfigLazy(t *testing.T) {
+	code := map[string]string{
+		"a.go": `package a
+
+			import "root/b"
+
+			func a() {
+				b.B()
+			}
+		`,
+		"b/b.go": `package b
+
+			func B() {}
+		`,
+		"go.mod": "module root\n\ngo 1.14",
+	}
+	dir, err := tempDir(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &LoadConfig{
+		Packages: &packages.Config{
+			Mode: packages.LoadAllSyntax,
+			Dir:  dir,
+		},
+		Lazy: true,
+	}
+	pkgs, err := LoadWithConfig(cfg, "root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pkgs[0].Syntax) != 1 {
+		t.Fatalf("expected root package to be decorated, found %d files", len(pkgs[0].Syntax))
+	}
+	b := pkgs[0].Imports["root/b"]
+	if len(b.Syntax) != 0 {
+		t.Fatalf("expected dependency not to be decorated, found %d files", len(b.Syntax))
+	}
+	for i := 0; i < 2; i++ {
+		if err := b.Decorate(); err != nil {
+			t.Fatal(err)
+		}
+		if len(b.Syntax) != 1 {
+			t.Fatalf("expected dependency to be decorated once, found %d files", len(b.Syntax))
+		}
+	}
+	if b.Decorator.Filenames[b.Syntax[0]] != b.GoFiles[0] {
+		t.Errorf("expected %s, found %s", b.GoFiles[0], b.Decorator.Filenames[b.Syntax[0]])
+	}
+}
+
+func TestLoadWithConfigTrack(t *testing.T) {
+	code := map[string]string{
+		"a.go":   "package a\n\nfunc a() {}\n",
+		"b.go":   "package a\n\nfunc b() {}\n",
+		"go.mod": "module root\n\ngo 1.14",
+	}
+	dir, err := tempDir(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &LoadConfig{
+		Packages: &packages.Config{
+			Mode: packages.LoadAllSyntax,
+			Dir:  dir,
+		},
+		Track: true,
+	}
+	pkgs, err := LoadWithConfig(cfg, "root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := pkgs[0]
+	a := pkg.Syntax[0]
+	a.Decls[0].(*dst.FuncDecl).Name.Name = "c"
+	if c := pkg.Changes(a); c == nil || len(c.Decls) != 1 {
+		t.Fatalf("expected one changed declaration, found %v", c)
+	}
+	if c := pkg.Changes(pkg.Syntax[1]); c == nil || !c.Empty() {
+		t.Fatalf("expected no changes, found %v", c)
+	}
+	var written []string
+	err = pkg.save(guess.New(), func(filename string, data []byte, perm os.FileMode) error {
+		written = append(written, filepath.Base(filename))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(written) != "[a.go]" {
+		t.Errorf("expected only a.go to be written, found %v", written)
+	}
+}
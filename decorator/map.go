@@ -40,3 +40,25 @@ type DstMap struct {
 	Objects map[*ast.Object]*dst.Object // Mapping from ast to dst Objects
 	Scopes  map[*ast.Scope]*dst.Scope   // Mapping from ast to dst Scopes
 }
+
+// merge adds the mappings in o to m.
+func (m Map) merge(o Map) {
+	for k, v := range o.Ast.Nodes {
+		m.Ast.Nodes[k] = v
+	}
+	for k, v := range o.Ast.Objects {
+		m.Ast.Objects[k] = v
+	}
+	for k, v := range o.Ast.Scopes {
+		m.Ast.Scopes[k] = v
+	}
+	for k, v := range o.Dst.Nodes {
+		m.Dst.Nodes[k] = v
+	}
+	for k, v := range o.Dst.Objects {
+		m.Dst.Objects[k] = v
+	}
+	for k, v := range o.Dst.Scopes {
+		m.Dst.Scopes[k] = v
+	}
+}
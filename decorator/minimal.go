@@ -0,0 +1,206 @@
+package decorator
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+
+	"github.com/dave/dst"
+)
+
+// FprintMinimal restores a *dst.File that was decorated by d, and writes the original source with
+// only the changed top level declarations replaced by their restored text. Unchanged declarations,
+// and the comments and spacing between them, are left byte for byte as they were in src, so code
+// that wasn't touched isn't reformatted. See FileRestorer.FprintMinimal.
+func (pr *Restorer) FprintMinimal(w io.Writer, d *Decorator, f *dst.File, src []byte) error {
+	return pr.FileRestorer().FprintMinimal(w, d, f, src)
+}
+
+// FprintMinimal restores a *dst.File that was decorated by d, and writes the original source with
+// only the changed top level declarations replaced by their restored text. src is the original
+// source of the file.
+//
+// A declaration is unchanged if it was decorated by d and is equal (see dst.Equal) to a fresh
+// decoration of its original ast node. Declarations that have been added, removed or moved are
+// written along with the source between them and the nearest unchanged declarations. If the
+// package clause or the decorations of the file have changed, or src doesn't match the file that
+// was decorated, the whole restored file is written as with Fprint.
+func (r *FileRestorer) FprintMinimal(w io.Writer, d *Decorator, f *dst.File, src []byte) error {
+
+	// restore first: import management may add or update import declarations in f
+	af, err := r.RestoreFile(f)
+	if err != nil {
+		return err
+	}
+	buf := &bytes.Buffer{}
+	if err := format.Node(buf, r.Fset, af); err != nil {
+		return err
+	}
+	out := buf.Bytes()
+
+	orig, ok := d.Ast.Nodes[f].(*ast.File)
+	if !ok {
+		_, err := w.Write(out)
+		return err
+	}
+	origFile := d.Fset.File(orig.Pos())
+	if origFile == nil || origFile.Size() != len(src) {
+		_, err := w.Write(out)
+		return err
+	}
+	fork := d.fork()
+	fresh, err := fork.DecorateFile(orig)
+	if err != nil {
+		return err
+	}
+	if !dst.Equal(f.Name, fresh.Name) || !equalFileDecorations(f.Decs, fresh.Decs) {
+		_, err := w.Write(out)
+		return err
+	}
+	outFset := token.NewFileSet()
+	parsed, err := parser.ParseFile(outFset, r.Name, out, parser.ParseComments)
+	if err != nil || len(parsed.Decls) != len(af.Decls) {
+		_, err := w.Write(out)
+		return err
+	}
+	outFile := outFset.File(parsed.Pos())
+
+	origIndex := map[ast.Node]int{}
+	for i, decl := range orig.Decls {
+		origIndex[decl] = i
+	}
+	// The dst declarations can include CommentDecl nodes, which have no ast node, so they are
+	// indexed separately.
+	dstIndex := map[dst.Decl]int{}
+	for i, decl := range f.Decls {
+		dstIndex[decl] = i
+	}
+	freshIndex := map[dst.Decl]int{}
+	for i, decl := range fresh.Decls {
+		freshIndex[decl] = i
+	}
+
+	// The unchanged declarations are anchors: the source between two anchors is taken from src if
+	// the declarations between them are unchanged (e.g. there are none in either file), otherwise
+	// from the output. The package clause is the first anchor and the end of the file is the last.
+	type anchor struct {
+		origDecl, outDecl  int
+		dstDecl, freshDecl int
+		origStart, origEnd int
+		outStart, outEnd   int
+	}
+	anchors := []anchor{{
+		origDecl:  -1,
+		outDecl:   -1,
+		dstDecl:   -1,
+		freshDecl: -1,
+		origEnd:   lineEnd(origFile, orig, orig.Name.End()),
+		outEnd:    lineEnd(outFile, parsed, parsed.Name.End()),
+	}}
+	for i, decl := range af.Decls {
+		dd, ok := r.Dst.Nodes[decl].(dst.Decl)
+		if !ok {
+			continue
+		}
+		j, ok := origIndex[d.Ast.Nodes[dd]]
+		if !ok || j <= anchors[len(anchors)-1].origDecl {
+			continue
+		}
+		fd := fork.Dst.Nodes[orig.Decls[j]].(dst.Decl)
+		if !dst.Equal(dd, fd) {
+			continue
+		}
+		a := anchor{origDecl: j, outDecl: i, dstDecl: dstIndex[dd], freshDecl: freshIndex[fd]}
+		a.origStart, a.origEnd = declRange(origFile, orig, orig.Decls[j])
+		a.outStart, a.outEnd = declRange(outFile, parsed, parsed.Decls[i])
+		anchors = append(anchors, a)
+	}
+	anchors = append(anchors, anchor{
+		origDecl:  len(orig.Decls),
+		outDecl:   len(parsed.Decls),
+		dstDecl:   len(f.Decls),
+		freshDecl: len(fresh.Decls),
+		origStart: len(src),
+		origEnd:   len(src),
+		outStart:  len(out),
+		outEnd:    len(out),
+	})
+
+	result := &bytes.Buffer{}
+	result.Write(src[:anchors[0].origEnd])
+	for i := 1; i < len(anchors); i++ {
+		prev, next := anchors[i-1], anchors[i]
+		if equalDecls(f.Decls[prev.dstDecl+1:next.dstDecl], fresh.Decls[prev.freshDecl+1:next.freshDecl]) {
+			result.Write(src[prev.origEnd:next.origStart])
+		} else {
+			result.Write(out[prev.outEnd:next.outStart])
+		}
+		result.Write(src[next.origStart:next.origEnd])
+	}
+	_, err = w.Write(result.Bytes())
+	return err
+}
+
+// declRange returns the offsets of a declaration including its doc comment and any comment on the
+// same line as its end.
+func declRange(tf *token.File, f *ast.File, decl ast.Decl) (start, end int) {
+	pos := decl.Pos()
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		if decl.Doc != nil {
+			pos = decl.Doc.Pos()
+		}
+	case *ast.GenDecl:
+		if decl.Doc != nil {
+			pos = decl.Doc.Pos()
+		}
+	}
+	return tf.Offset(pos), lineEnd(tf, f, decl.End())
+}
+
+// lineEnd returns the offset of end, extended to the end of any comments that start on the same
+// line.
+func lineEnd(tf *token.File, f *ast.File, end token.Pos) int {
+	line := tf.Line(end)
+	for _, cg := range f.Comments {
+		for _, c := range cg.List {
+			if c.Pos() >= end && tf.Line(c.Pos()) == line {
+				end = c.End()
+			}
+		}
+	}
+	return tf.Offset(end)
+}
+
+// equalDecls returns true if the declarations are equal (see dst.Equal).
+func equalDecls(a, b []dst.Decl) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !dst.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalFileDecorations(a, b dst.FileDecorations) bool {
+	if a.Before != b.Before || a.After != b.After {
+		return false
+	}
+	for _, pair := range [][2]dst.Decorations{{a.Start, b.Start}, {a.End, b.End}, {a.Package, b.Package}, {a.Name, b.Name}} {
+		if len(pair[0]) != len(pair[1]) {
+			return false
+		}
+		for i := range pair[0] {
+			if pair[0][i] != pair[1][i] {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,120 @@
+package decorator
+
+import (
+	"bytes"
+	"go/token"
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator/resolver/guess"
+)
+
+func TestFprintMinimal(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy AttachmentPolicy
+		code   string
+		mutate func(f *dst.File)
+		expect string
+	}{
+		{
+			name:   "unchanged",
+			code:   "package a\n\nvar a  =  1 // a\n\nfunc b( ) {  }\n",
+			expect: "package a\n\nvar a  =  1 // a\n\nfunc b( ) {  }\n",
+		},
+		{
+			name: "changed-decl",
+			code: "package a\n\nvar a  =  1 // a\n\n// b\nfunc b( ) {  }\n\nvar c  =  2\n",
+			mutate: func(f *dst.File) {
+				f.Decls[1].(*dst.FuncDecl).Name.Name = "d"
+			},
+			expect: "package a\n\nvar a  =  1 // a\n\n// b\nfunc d() {}\n\nvar c  =  2\n",
+		},
+		{
+			name: "added-decl",
+			code: "package a\n\nvar a  =  1\n\nvar b  =  2\n",
+			mutate: func(f *dst.File) {
+				decl := &dst.GenDecl{
+					Tok:   token.VAR,
+					Specs: []dst.Spec{&dst.ValueSpec{Names: []*dst.Ident{dst.NewIdent("c")}, Values: []dst.Expr{&dst.BasicLit{Kind: token.INT, Value: "3"}}}},
+				}
+				decl.Decs.Before = dst.EmptyLine
+				f.Decls = []dst.Decl{f.Decls[0], decl, f.Decls[1]}
+			},
+			expect: "package a\n\nvar a  =  1\n\nvar c = 3\n\nvar b  =  2\n",
+		},
+		{
+			name: "removed-decl",
+			code: "package a\n\nvar a  =  1\n\nvar b  =  2\n\nvar c  =  3\n",
+			mutate: func(f *dst.File) {
+				f.Decls = []dst.Decl{f.Decls[0], f.Decls[2]}
+			},
+			expect: "package a\n\nvar a  =  1\n\nvar c  =  3\n",
+		},
+		{
+			name: "moved-decl",
+			code: "package a\n\nvar a  =  1\n\nvar b  =  2\n\nvar c  =  3\n",
+			mutate: func(f *dst.File) {
+				f.Decls = []dst.Decl{f.Decls[0], f.Decls[2], f.Decls[1]}
+			},
+			expect: "package a\n\nvar a  =  1\n\nvar c  =  3\n\nvar b = 2\n",
+		},
+		{
+			name: "added-import",
+			code: "package a\n\nfunc a( ) {  }\n\nfunc b( ) {  }\n",
+			mutate: func(f *dst.File) {
+				fd := f.Decls[1].(*dst.FuncDecl)
+				stmt := &dst.ExprStmt{X: &dst.CallExpr{Fun: &dst.Ident{Name: "Println", Path: "fmt"}}}
+				stmt.Decs.Before, stmt.Decs.After = dst.NewLine, dst.NewLine
+				fd.Body.List = append(fd.Body.List, stmt)
+			},
+			expect: "package a\n\nimport \"fmt\"\n\nfunc a( ) {  }\n\nfunc b() {\n\tfmt.Println()\n}\n",
+		},
+		{
+			name:   "comment-decl",
+			policy: AttachmentPolicy{CommentNodes: true},
+			code:   "package a\n\nvar a  =  1\n\n// b\n\nvar c  =  3\n\n// d\n",
+			mutate: func(f *dst.File) {
+				f.Decls[2].(*dst.GenDecl).Specs[0].(*dst.ValueSpec).Names[0].Name = "e"
+			},
+			expect: "package a\n\nvar a  =  1\n\n// b\n\nvar e = 3\n\n// d\n",
+		},
+		{
+			name:   "removed-comment-decl",
+			policy: AttachmentPolicy{CommentNodes: true},
+			code:   "package a\n\nvar a  =  1\n\n// b\n\nvar c  =  3\n",
+			mutate: func(f *dst.File) {
+				f.Decls = []dst.Decl{f.Decls[0], f.Decls[2]}
+			},
+			expect: "package a\n\nvar a  =  1\n\nvar c  =  3\n",
+		},
+		{
+			name: "changed-package",
+			code: "package a\n\nvar a  =  1\n",
+			mutate: func(f *dst.File) {
+				f.Name.Name = "b"
+			},
+			expect: "package b\n\nvar a = 1\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := NewDecorator(token.NewFileSet())
+			d.AttachmentPolicy = test.policy
+			f, err := d.Parse(test.code)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.mutate != nil {
+				test.mutate(f)
+			}
+			buf := &bytes.Buffer{}
+			if err := NewRestorerWithImports("a", guess.New()).FprintMinimal(buf, d, f, []byte(test.code)); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != test.expect {
+				t.Errorf("diff: %s", diff(test.expect, buf.String()))
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package decorator
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/dave/dst"
+)
+
+// Point is a decoration point of a node in the original source.
+type Point struct {
+	Node     dst.Node
+	Name     string         // The name of the decoration point (e.g. "Start")
+	Pos      token.Position // The position of the decoration point in the original source
+	Comments []*ast.Comment // The comments in the original source attached to the decoration point
+}
+
+// Points returns the decoration points of the nodes in f in the order they occur in the original
+// source, with the comments that were attached to each one. f must have been decorated by d.
+// Points repeats the first stage of decoration, so it's intended for debugging tools (e.g. the
+// dump command) rather than for every rewrite. Points of ast nodes that don't have a matching dst
+// node (e.g. the package name of a qualified identifier) are omitted.
+func (d *Decorator) Points(f *dst.File) ([]Point, error) {
+	af, ok := d.Ast.Nodes[f].(*ast.File)
+	if !ok {
+		return nil, &DecorateError{Reason: "file was not decorated by this Decorator"}
+	}
+	fd := d.newFileDecorator()
+	fd.file = af
+	fd.fragment(af)
+	fd.link()
+
+	comments := map[token.Pos]*ast.Comment{}
+	for _, cg := range af.Comments {
+		for _, c := range cg.List {
+			comments[c.Slash] = c
+		}
+	}
+
+	var points []Point
+	index := map[*decorationFragment]int{}
+	for _, frag := range fd.fragments {
+		frag, ok := frag.(*decorationFragment)
+		if !ok {
+			continue
+		}
+		n, ok := d.Dst.Nodes[frag.Node]
+		if !ok {
+			continue
+		}
+		index[frag] = len(points)
+		points = append(points, Point{Node: n, Name: frag.Name, Pos: d.Fset.Position(frag.Pos)})
+	}
+	for _, frag := range fd.fragments {
+		frag, ok := frag.(*commentFragment)
+		if !ok || frag.Attached == nil {
+			continue
+		}
+		i, ok := index[frag.Attached]
+		if !ok {
+			continue
+		}
+		points[i].Comments = append(points[i].Comments, comments[frag.Pos])
+	}
+	return points, nil
+}
@@ -0,0 +1,46 @@
+package decorator
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/dave/dst"
+)
+
+func TestPoints(t *testing.T) {
+	code := "package a\n\n// a\nfunc a() {\n\tb( /* b */ ) // c\n}\n"
+	d := NewDecorator(token.NewFileSet())
+	f, err := d.Parse(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	points, err := d.Points(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var found []string
+	for _, p := range points {
+		if len(p.Comments) == 0 {
+			continue
+		}
+		var texts []string
+		for _, c := range p.Comments {
+			texts = append(texts, c.Text)
+		}
+		found = append(found, fmt.Sprintf("%T.%s %d:%d %s", p.Node, p.Name, p.Pos.Line, p.Pos.Column, strings.Join(texts, " ")))
+	}
+	expect := []string{
+		"*dst.FuncDecl.Start 4:1 // a",
+		"*dst.CallExpr.Lparen 5:4 /* b */",
+		"*dst.ExprStmt.End 5:14 // c",
+	}
+	if strings.Join(found, "\n") != strings.Join(expect, "\n") {
+		t.Errorf("expected:\n%s\nfound:\n%s", strings.Join(expect, "\n"), strings.Join(found, "\n"))
+	}
+
+	if _, err := d.Points(&dst.File{Name: dst.NewIdent("a")}); err == nil {
+		t.Error("expected an error for a file that wasn't decorated")
+	}
+}
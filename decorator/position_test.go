@@ -0,0 +1,72 @@
+package decorator
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dave/dst"
+)
+
+func TestDecoratorPosition(t *testing.T) {
+	code := "package a\n\nfunc a() {\n\t// b\n\tb(c, d)\n}\n"
+	d := NewDecorator(nil)
+	f, err := d.ParseFile("a.go", code, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	call := f.Decls[0].(*dst.FuncDecl).Body.List[0].(*dst.ExprStmt).X.(*dst.CallExpr)
+	if found, expect := d.Position(call.Args[1]).String(), "a.go:5:7"; found != expect {
+		t.Errorf("expected %s, found %s", expect, found)
+	}
+	if p := d.Position(dst.NewIdent("e")); p.IsValid() {
+		t.Error("expected invalid position for new node")
+	}
+}
+
+func TestRestorerPosition(t *testing.T) {
+	code := "package a\n\nfunc a() {\n\tb(c, d)\n}\n"
+	f, err := Parse(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := f.Decls[0].(*dst.FuncDecl).Body
+	existing := body.List[0].(*dst.ExprStmt).X.(*dst.CallExpr).Args[1]
+	added := &dst.IfStmt{
+		Cond: dst.NewIdent("e"),
+		Body: &dst.BlockStmt{List: []dst.Stmt{&dst.ExprStmt{X: &dst.CallExpr{Fun: dst.NewIdent("f")}}}},
+	}
+	added.Decs.Before = dst.NewLine
+	added.Decs.Start.Append("// e")
+	body.List = append([]dst.Stmt{added}, body.List...)
+
+	r := NewRestorer().FileRestorer()
+	r.Name = "a.go"
+	buf := &bytes.Buffer{}
+	if err := r.Fprint(buf, f); err != nil {
+		t.Fatal(err)
+	}
+	expect := "package a\n\nfunc a() {\n\t// e\n\tif e {\n\t\tf()\n\t}\n\tb(c, d)\n}\n"
+	if buf.String() != expect {
+		t.Fatalf("diff:\n%s", diff(expect, buf.String()))
+	}
+	tests := []struct {
+		node        dst.Node
+		expect, end string
+	}{
+		{added, "a.go:5:2", "a.go:7:3"},
+		{added.Body.List[0], "a.go:6:3", "a.go:6:6"},
+		{existing, "a.go:8:7", "a.go:8:8"},
+	}
+	for _, test := range tests {
+		if found := r.Position(test.node).String(); found != test.expect {
+			t.Errorf("expected %s, found %s", test.expect, found)
+		}
+		pos, end, err := r.Range(test.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pos.String() != test.expect || end.String() != test.end {
+			t.Errorf("expected %s-%s, found %s-%s", test.expect, test.end, pos, end)
+		}
+	}
+}
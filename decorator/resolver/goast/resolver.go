@@ -99,7 +99,11 @@ func (r *DecoratorResolver) imports(file *ast.File) (map[string]string, error) {
 			}
 			return true
 		case *ast.ImportSpec:
-			path := mustUnquote(node.Path.Value)
+			path, err := strconv.Unquote(node.Path.Value)
+			if err != nil {
+				outer = fmt.Errorf("goast.DecoratorResolver invalid import path %s: %w", node.Path.Value, err)
+				return false
+			}
 			if path == "C" {
 				return false
 			}
@@ -139,11 +143,3 @@ func (r *DecoratorResolver) imports(file *ast.File) (map[string]string, error) {
 
 	return imports, nil
 }
-
-func mustUnquote(s string) string {
-	out, err := strconv.Unquote(s)
-	if err != nil {
-		panic(err)
-	}
-	return out
-}
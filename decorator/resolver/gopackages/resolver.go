@@ -33,19 +33,21 @@ func (r *RestorerResolver) ResolvePackage(path string) (string, error) {
 		return name, nil
 	}
 
+	// copy the config so ResolvePackage can be called concurrently
+	config := r.Config
 	if r.Dir != "" {
-		r.Config.Dir = r.Dir
+		config.Dir = r.Dir
 	}
-	r.Config.Mode = packages.LoadTypes
-	r.Config.Tests = false
+	config.Mode = packages.LoadTypes
+	config.Tests = false
 
-	pkgs, err := packages.Load(&r.Config, "pattern="+path)
+	pkgs, err := packages.Load(&config, "pattern="+path)
 	if err != nil {
 		return "", err
 	}
 
 	if len(pkgs) > 1 {
-		return "", fmt.Errorf("%d packages found for %s, %s", len(pkgs), path, r.Config.Dir)
+		return "", fmt.Errorf("%d packages found for %s, %s", len(pkgs), path, config.Dir)
 	}
 	if len(pkgs) == 0 {
 		return "", resolver.ErrPackageNotFound
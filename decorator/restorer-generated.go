@@ -13,7 +13,10 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 		if allowDuplicate {
 			return an
 		} else {
-			panic(fmt.Sprintf("duplicate node: %#v", n))
+			panic(&RestoreError{
+				Node:   n,
+				Reason: "duplicate node",
+			})
 		}
 	}
 	switch n := n.(type) {
@@ -219,6 +222,10 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 
 		// List: List
 		for _, v := range n.List {
+			if _, ok := v.(*dst.CommentStmt); ok {
+				r.restoreNode(v, "BlockStmt", "List", "Stmt", allowDuplicate)
+				continue
+			}
 			out.List = append(out.List, r.restoreNode(v, "BlockStmt", "List", "Stmt", allowDuplicate).(ast.Stmt))
 		}
 
@@ -344,6 +351,10 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 
 		// List: Body
 		for _, v := range n.Body {
+			if _, ok := v.(*dst.CommentStmt); ok {
+				r.restoreNode(v, "CaseClause", "Body", "Stmt", allowDuplicate)
+				continue
+			}
 			out.Body = append(out.Body, r.restoreNode(v, "CaseClause", "Body", "Stmt", allowDuplicate).(ast.Stmt))
 		}
 
@@ -423,6 +434,12 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 
 		// Node: Comm
 		if n.Comm != nil {
+			if _, ok := n.Comm.(*dst.CommentStmt); ok {
+				panic(&RestoreError{
+					Node:   n.Comm,
+					Reason: "CommentStmt outside a list of statements",
+				})
+			}
 			out.Comm = r.restoreNode(n.Comm, "CommClause", "Comm", "Stmt", allowDuplicate).(ast.Stmt)
 		}
 
@@ -438,6 +455,10 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 
 		// List: Body
 		for _, v := range n.Body {
+			if _, ok := v.(*dst.CommentStmt); ok {
+				r.restoreNode(v, "CommClause", "Body", "Stmt", allowDuplicate)
+				continue
+			}
 			out.Body = append(out.Body, r.restoreNode(v, "CommClause", "Body", "Stmt", allowDuplicate).(ast.Stmt))
 		}
 
@@ -446,6 +467,30 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 		r.applySpace(n, "After", n.Decs.After)
 
 		return out
+	case *dst.CommentDecl:
+		// No ast node is created, so only the decorations are restored
+		r.applySpace(n, "Before", n.Decs.Before)
+
+		// Decoration: Start
+		r.applyDecorations(nil, "Start", n.Decs.Start, false)
+
+		// Decoration: End
+		r.applyDecorations(nil, "End", n.Decs.End, true)
+		r.applySpace(n, "After", n.Decs.After)
+
+		return nil
+	case *dst.CommentStmt:
+		// No ast node is created, so only the decorations are restored
+		r.applySpace(n, "Before", n.Decs.Before)
+
+		// Decoration: Start
+		r.applyDecorations(nil, "Start", n.Decs.Start, false)
+
+		// Decoration: End
+		r.applyDecorations(nil, "End", n.Decs.End, true)
+		r.applySpace(n, "After", n.Decs.After)
+
+		return nil
 	case *dst.CompositeLit:
 		out := &ast.CompositeLit{}
 		r.Ast.Nodes[n] = out
@@ -498,6 +543,12 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 
 		// Node: Decl
 		if n.Decl != nil {
+			if _, ok := n.Decl.(*dst.CommentDecl); ok {
+				panic(&RestoreError{
+					Node:   n.Decl,
+					Reason: "CommentDecl outside File.Decls",
+				})
+			}
 			out.Decl = r.restoreNode(n.Decl, "DeclStmt", "Decl", "Decl", allowDuplicate).(ast.Decl)
 		}
 
@@ -692,6 +743,10 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 
 		// List: Decls
 		for _, v := range n.Decls {
+			if _, ok := v.(*dst.CommentDecl); ok {
+				r.restoreNode(v, "File", "Decls", "Decl", allowDuplicate)
+				continue
+			}
 			out.Decls = append(out.Decls, r.restoreNode(v, "File", "Decls", "Decl", allowDuplicate).(ast.Decl))
 		}
 
@@ -721,6 +776,12 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 
 		// Node: Init
 		if n.Init != nil {
+			if _, ok := n.Init.(*dst.CommentStmt); ok {
+				panic(&RestoreError{
+					Node:   n.Init,
+					Reason: "CommentStmt outside a list of statements",
+				})
+			}
 			out.Init = r.restoreNode(n.Init, "ForStmt", "Init", "Stmt", allowDuplicate).(ast.Stmt)
 		}
 
@@ -747,6 +808,12 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 
 		// Node: Post
 		if n.Post != nil {
+			if _, ok := n.Post.(*dst.CommentStmt); ok {
+				panic(&RestoreError{
+					Node:   n.Post,
+					Reason: "CommentStmt outside a list of statements",
+				})
+			}
 			out.Post = r.restoreNode(n.Post, "ForStmt", "Post", "Stmt", allowDuplicate).(ast.Stmt)
 		}
 
@@ -1038,6 +1105,12 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 
 		// Node: Init
 		if n.Init != nil {
+			if _, ok := n.Init.(*dst.CommentStmt); ok {
+				panic(&RestoreError{
+					Node:   n.Init,
+					Reason: "CommentStmt outside a list of statements",
+				})
+			}
 			out.Init = r.restoreNode(n.Init, "IfStmt", "Init", "Stmt", allowDuplicate).(ast.Stmt)
 		}
 
@@ -1067,6 +1140,12 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 
 		// Node: Else
 		if n.Else != nil {
+			if _, ok := n.Else.(*dst.CommentStmt); ok {
+				panic(&RestoreError{
+					Node:   n.Else,
+					Reason: "CommentStmt outside a list of statements",
+				})
+			}
 			out.Else = r.restoreNode(n.Else, "IfStmt", "Else", "Stmt", allowDuplicate).(ast.Stmt)
 		}
 
@@ -1300,6 +1379,12 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 
 		// Node: Stmt
 		if n.Stmt != nil {
+			if _, ok := n.Stmt.(*dst.CommentStmt); ok {
+				panic(&RestoreError{
+					Node:   n.Stmt,
+					Reason: "CommentStmt outside a list of statements",
+				})
+			}
 			out.Stmt = r.restoreNode(n.Stmt, "LabeledStmt", "Stmt", "Stmt", allowDuplicate).(ast.Stmt)
 		}
 
@@ -1730,6 +1815,12 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 
 		// Node: Init
 		if n.Init != nil {
+			if _, ok := n.Init.(*dst.CommentStmt); ok {
+				panic(&RestoreError{
+					Node:   n.Init,
+					Reason: "CommentStmt outside a list of statements",
+				})
+			}
 			out.Init = r.restoreNode(n.Init, "SwitchStmt", "Init", "Stmt", allowDuplicate).(ast.Stmt)
 		}
 
@@ -1862,6 +1953,12 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 
 		// Node: Init
 		if n.Init != nil {
+			if _, ok := n.Init.(*dst.CommentStmt); ok {
+				panic(&RestoreError{
+					Node:   n.Init,
+					Reason: "CommentStmt outside a list of statements",
+				})
+			}
 			out.Init = r.restoreNode(n.Init, "TypeSwitchStmt", "Init", "Stmt", allowDuplicate).(ast.Stmt)
 		}
 
@@ -1870,6 +1967,12 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 
 		// Node: Assign
 		if n.Assign != nil {
+			if _, ok := n.Assign.(*dst.CommentStmt); ok {
+				panic(&RestoreError{
+					Node:   n.Assign,
+					Reason: "CommentStmt outside a list of statements",
+				})
+			}
 			out.Assign = r.restoreNode(n.Assign, "TypeSwitchStmt", "Assign", "Stmt", allowDuplicate).(ast.Stmt)
 		}
 
@@ -1951,6 +2054,9 @@ func (r *FileRestorer) restoreNode(n dst.Node, parentName, parentField, parentFi
 
 		return out
 	default:
-		panic(fmt.Sprintf("%T", n))
+		panic(&RestoreError{
+			Node:   n,
+			Reason: fmt.Sprintf("unsupported node type %T", n),
+		})
 	}
 }
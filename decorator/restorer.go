@@ -1,12 +1,16 @@
 package decorator
 
 import (
+	"bytes"
 	"fmt"
 	"go/ast"
 	"go/format"
+	"go/parser"
+	"go/printer"
 	"go/token"
 	"io"
 	"os"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
@@ -65,6 +69,16 @@ func (pr *Restorer) RestoreFile(file *dst.File) (*ast.File, error) {
 	return pr.FileRestorer().RestoreFile(file)
 }
 
+// RestoreNode restores any dst.Node to an ast.Node. See FileRestorer.RestoreNode.
+func (pr *Restorer) RestoreNode(n dst.Node) (ast.Node, error) {
+	return pr.FileRestorer().RestoreNode(n)
+}
+
+// FprintNode uses format.Node to print any dst.Node with its decorations to a writer.
+func (pr *Restorer) FprintNode(w io.Writer, n dst.Node) error {
+	return pr.FileRestorer().FprintNode(w, n)
+}
+
 // FileRestorer restores a specific file with extra options
 func (pr *Restorer) FileRestorer() *FileRestorer {
 	return &FileRestorer{
@@ -83,10 +97,19 @@ type FileRestorer struct {
 	comments        []*ast.CommentGroup
 	base            int
 	cursor          token.Pos
-	nodeDecl        map[*ast.Object]dst.Node // Objects that have a ast.Node Decl (look up after file has been rendered)
-	nodeData        map[*ast.Object]dst.Node // Objects that have a ast.Node Data (look up after file has been rendered)
-	cursorAtNewLine token.Pos                // The cursor position directly after adding a newline decoration (or a line comment which ends in a "\n"). If we're still at this cursor position when we add a line space, reduce the "\n" by one.
-	packageNames    map[string]string        // names in the code of all imported packages ("." for dot-imports)
+	nodeDecl        map[*ast.Object]dst.Node  // Objects that have a ast.Node Decl (look up after file has been rendered)
+	nodeData        map[*ast.Object]dst.Node  // Objects that have a ast.Node Data (look up after file has been rendered)
+	cursorAtNewLine token.Pos                 // The cursor position directly after adding a newline decoration (or a line comment which ends in a "\n"). If we're still at this cursor position when we add a line space, reduce the "\n" by one.
+	packageNames    map[string]string         // names in the code of all imported packages ("." for dot-imports)
+	printedFile     *ast.File                 // the file restored by the last Fprint
+	printedSrc      []byte                    // the output of the last Fprint
+	printed         map[ast.Node]printedRange // positions of restored nodes in printedSrc (built by the first Position or Range call)
+	printedErr      error                     // the error from matching the restored nodes to the printed source
+}
+
+// printedRange is the position and end of a node in the printed source.
+type printedRange struct {
+	pos, end token.Position
 }
 
 // Print uses format.Node to print a *dst.File to stdout
@@ -100,52 +123,272 @@ func (r *FileRestorer) Fprint(w io.Writer, f *dst.File) error {
 	if err != nil {
 		return err
 	}
-	return format.Node(w, r.Fset, af)
+	buf := &bytes.Buffer{}
+	if err := format.Node(buf, r.Fset, af); err != nil {
+		return err
+	}
+	r.printedFile, r.printedSrc = af, buf.Bytes()
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// Position returns the position of n in the restored output. If the file was printed with Fprint,
+// this is the exact position in the printed source. Otherwise it is the position in the FileSet
+// after RestoreFile or RestoreNode: the line is correct but the column may not match the output
+// of the printer. If n has not been restored, the zero Position is returned. The printed source
+// is parsed to find the exact positions the first time Position is called after Fprint, so Fprint
+// itself doesn't pay for it.
+func (r *FileRestorer) Position(n dst.Node) token.Position {
+	pos, _, _ := r.Range(n)
+	return pos
+}
+
+// Range returns the position and end of n in the restored output, in the same way as Position.
+// After Fprint, an error is returned if the nodes in the printed source can't be matched to the
+// restored nodes, and the positions are from the FileSet.
+func (r *FileRestorer) Range(n dst.Node) (pos, end token.Position, err error) {
+	an, ok := r.Ast.Nodes[n]
+	if !ok {
+		return token.Position{}, token.Position{}, nil
+	}
+	if r.printed == nil && r.printedFile != nil {
+		r.printedErr = r.mapPositions(r.printedFile, r.printedSrc)
+		r.printedFile, r.printedSrc = nil, nil
+	}
+	if p, ok := r.printed[an]; ok {
+		return p.pos, p.end, nil
+	}
+	return r.Fset.Position(an.Pos()), r.Fset.Position(an.End()), r.printedErr
+}
+
+// mapPositions parses the printed output and records the position of each restored node. The
+// restored and parsed files have the same structure, so the nodes are matched by walking both
+// in order. Comments are skipped because the restorer groups them differently.
+func (r *FileRestorer) mapPositions(af *ast.File, src []byte) error {
+	r.printed = map[ast.Node]printedRange{}
+	fset := token.NewFileSet()
+	pf, err := parser.ParseFile(fset, r.Name, src, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	nodes := func(f *ast.File) []ast.Node {
+		var out []ast.Node
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch n.(type) {
+			case nil:
+				return false
+			case *ast.CommentGroup, *ast.Comment:
+				return false
+			}
+			out = append(out, n)
+			return true
+		})
+		return out
+	}
+	restored, parsed := nodes(af), nodes(pf)
+	if len(restored) != len(parsed) {
+		return fmt.Errorf("restored file has %d nodes but the printed file has %d", len(restored), len(parsed))
+	}
+	printed := map[ast.Node]printedRange{}
+	for i, n := range restored {
+		if reflect.TypeOf(n) != reflect.TypeOf(parsed[i]) {
+			return fmt.Errorf("restored %T doesn't match %T in the printed file at %s", n, parsed[i], fset.Position(parsed[i].Pos()))
+		}
+		printed[n] = printedRange{pos: fset.Position(parsed[i].Pos()), end: fset.Position(parsed[i].End())}
+	}
+	r.printed = printed
+	return nil
+}
+
+// RestoreFile restores a *dst.File to *ast.File. If the file can't be restored, the error is a
+// *RestoreError.
+func (r *FileRestorer) RestoreFile(file *dst.File) (_ *ast.File, err error) {
+
+	defer recoverRestoreError(&err)
+
+	if err := r.reset(); err != nil {
+		return nil, err
+	}
+
+	r.file = file
+
+	if err := r.updateImports(); err != nil {
+		return nil, err
+	}
+
+	// restore the file, populate comments and lines
+	f := r.restoreNode(r.file, "", "", "", false).(*ast.File)
+
+	for _, cg := range r.comments {
+		f.Comments = append(f.Comments, cg)
+	}
+
+	if err := r.finish(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// RestoreNode restores any dst.Node (e.g. an expression, statement or declaration) to an ast.Node.
+// If n is a *dst.File, this is equivalent to RestoreFile. The restored comments are not attached
+// to the node, so use Comments to get them (e.g. to print the node with printer.CommentedNode).
+// If a Resolver is set, qualified identifiers are restored using the package name (or the alias
+// from the Alias map), but there is no import block to update. If the node can't be restored, the
+// error is a *RestoreError. A CommentStmt or CommentDecl has no ast equivalent, so it can't be
+// restored on its own (use FprintNode to print its comments).
+func (r *FileRestorer) RestoreNode(n dst.Node) (ast.Node, error) {
+
+	if f, ok := n.(*dst.File); ok {
+		return r.RestoreFile(f)
+	}
+
+	out, err := r.restoreRoot(n)
+	if err != nil {
+		return nil, err
+	}
+	if out == nil {
+		return nil, &RestoreError{Node: n, Reason: fmt.Sprintf("%T has no ast node", n)}
+	}
+
+	return out, nil
 }
 
-// RestoreFile restores a *dst.File to *ast.File
-func (r *FileRestorer) RestoreFile(file *dst.File) (*ast.File, error) {
+// restoreRoot restores a node that isn't a file. The result is nil for a CommentStmt or
+// CommentDecl, which only restore their comments.
+func (r *FileRestorer) restoreRoot(n dst.Node) (_ ast.Node, err error) {
+
+	defer recoverRestoreError(&err)
+
+	if err := r.reset(); err != nil {
+		return nil, err
+	}
+
+	r.file = nil
+
+	if err := r.updatePackageNames(n); err != nil {
+		return nil, err
+	}
+
+	out := r.restoreNode(n, "", "", "", false)
+
+	if err := r.finish(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// Comments returns the comments restored by the last call to RestoreFile or RestoreNode.
+func (r *FileRestorer) Comments() []*ast.CommentGroup {
+	return r.comments
+}
+
+// FprintNode uses format.Node to print any dst.Node with its decorations to a writer. For a
+// CommentStmt or CommentDecl only the comments are printed.
+func (r *FileRestorer) FprintNode(w io.Writer, n dst.Node) error {
+	if f, ok := n.(*dst.File); ok {
+		af, err := r.RestoreFile(f)
+		if err != nil {
+			return err
+		}
+		return format.Node(w, r.Fset, af)
+	}
+	an, err := r.restoreRoot(n)
+	if err != nil {
+		return err
+	}
+
+	// The printer only prints comments inside the range of the node, so comments in the Start and
+	// End decorations are printed separately.
+	var before, inside, after []*ast.CommentGroup
+	for _, cg := range r.comments {
+		switch {
+		case an == nil || cg.End() <= an.Pos():
+			before = append(before, cg)
+		case cg.Pos() >= an.End():
+			after = append(after, cg)
+		default:
+			inside = append(inside, cg)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	var prev token.Pos
+	space := func(pos token.Pos) {
+		if !prev.IsValid() {
+			return
+		}
+		switch lines := r.Fset.Position(pos).Line - r.Fset.Position(prev).Line; {
+		case lines > 1:
+			buf.WriteString("\n\n")
+		case lines == 1:
+			buf.WriteString("\n")
+		default:
+			buf.WriteString(" ")
+		}
+	}
+	comments := func(groups []*ast.CommentGroup) {
+		for _, cg := range groups {
+			for _, c := range cg.List {
+				space(c.Pos())
+				buf.WriteString(c.Text)
+				prev = c.End() - 1 // the end of a line comment is the start of the next line
+			}
+		}
+	}
+
+	comments(before)
+	if an != nil {
+		space(an.Pos())
+		if err := format.Node(buf, r.Fset, &printer.CommentedNode{Node: an, Comments: inside}); err != nil {
+			return err
+		}
+		prev = an.End() - 1
+	}
+	comments(after)
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// reset prepares the FileRestorer to restore a file or node, but leaves Name and the Alias map
+// unchanged
+func (r *FileRestorer) reset() error {
 
 	if r.Resolver == nil && r.Path != "" {
-		panic("Restorer Path should be empty when Resolver is nil")
+		return &RestoreError{Reason: "Restorer Path should be empty when Resolver is nil"}
 	}
 
 	if r.Resolver != nil && r.Path == "" {
-		panic("Restorer Path should be set when Resolver is set")
+		return &RestoreError{Reason: "Restorer Path should be set when Resolver is set"}
 	}
 
 	if r.Fset == nil {
 		r.Fset = token.NewFileSet()
 	}
 
-	// reset the FileRestorer, but leave Name and the Alias map unchanged
-
-	r.file = file
 	r.lines = []int{0} // initialise with the first line at Pos 0
 	r.nodeDecl = map[*ast.Object]dst.Node{}
 	r.nodeData = map[*ast.Object]dst.Node{}
 	r.packageNames = map[string]string{}
 	r.comments = []*ast.CommentGroup{}
 	r.cursorAtNewLine = 0
-	r.packageNames = map[string]string{}
+	r.printed = nil
+	r.printedFile, r.printedSrc, r.printedErr = nil, nil, nil
 
 	r.base = r.Fset.Base() // base is the pos that the file will start at in the fset
 	r.cursor = token.Pos(r.base)
 
-	if err := r.updateImports(); err != nil {
-		return nil, err
-	}
-
-	// restore the file, populate comments and lines
-	f := r.restoreNode(r.file, "", "", "", false).(*ast.File)
+	return nil
+}
 
-	for _, cg := range r.comments {
-		f.Comments = append(f.Comments, cg)
-	}
+// finish adds the restored file to the FileSet and restores the Extras if needed.
+func (r *FileRestorer) finish() error {
 
 	ff := r.Fset.AddFile(r.Name, r.base, r.fileSize())
 	if !ff.SetLines(r.lines) {
-		panic("ff.SetLines failed")
+		return &RestoreError{Reason: "invalid line offsets in restored file"}
 	}
 
 	if r.Extras {
@@ -160,7 +403,64 @@ func (r *FileRestorer) RestoreFile(file *dst.File) (*ast.File, error) {
 		}
 	}
 
-	return f, nil
+	return nil
+}
+
+// updatePackageNames is used instead of updateImports when restoring a node that is not a file.
+// There's no imports block, so the names of all packages in use are resolved, and the Alias map
+// is used to override them.
+func (r *FileRestorer) updatePackageNames(n dst.Node) error {
+
+	if r.Resolver == nil {
+		return nil
+	}
+
+	packagesInUse := map[string]bool{}
+	dst.Inspect(n, func(n dst.Node) bool {
+		if id, ok := n.(*dst.Ident); ok && id.Path != "" && id.Path != r.Path {
+			packagesInUse[id.Path] = true
+		}
+		return true
+	})
+
+	var paths []string
+	for path := range packagesInUse {
+		paths = append(paths, path)
+	}
+	sort.Slice(paths, func(i, j int) bool { return packagePathOrderLess(paths[i], paths[j]) })
+
+	conflict := func(name string) bool {
+		for _, n := range r.packageNames {
+			if name == n {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, path := range paths {
+		name := r.Alias[path]
+		switch name {
+		case ".":
+			r.packageNames[path] = ""
+			continue
+		case "", "_":
+			resolved, err := r.Resolver.ResolvePackage(path)
+			if err != nil {
+				return fmt.Errorf("could not resolve package %s: %w", path, err)
+			}
+			name = resolved
+		}
+		current := name
+		modifier := 1
+		for conflict(current) {
+			current = fmt.Sprintf("%s%d", name, modifier)
+			modifier++
+		}
+		r.packageNames[path] = current
+	}
+
+	return nil
 }
 
 func (r *FileRestorer) updateImports() error {
@@ -204,14 +504,14 @@ func (r *FileRestorer) updateImports() error {
 				return true
 			}
 			// if this block has 1 spec and it's the "C" import, ignore it.
-			if len(n.Specs) == 1 && mustUnquote(n.Specs[0].(*dst.ImportSpec).Path.Value) == "C" {
+			if len(n.Specs) == 1 && importPath(n.Specs[0].(*dst.ImportSpec)) == "C" {
 				hasCgoBlock = true
 				return true
 			}
 			blocks = append(blocks, n)
 
 		case *dst.ImportSpec:
-			path := mustUnquote(n.Path.Value)
+			path := importPath(n)
 			if n.Name == nil {
 				importsFound[path] = ""
 			} else {
@@ -389,8 +689,8 @@ func (r *FileRestorer) updateImports() error {
 		// rearrange import block
 		sort.Slice(blocks[0].Specs, func(i, j int) bool {
 			return packagePathOrderLess(
-				mustUnquote(blocks[0].Specs[i].(*dst.ImportSpec).Path.Value),
-				mustUnquote(blocks[0].Specs[j].(*dst.ImportSpec).Path.Value),
+				importPath(blocks[0].Specs[i].(*dst.ImportSpec)),
+				importPath(blocks[0].Specs[j].(*dst.ImportSpec)),
 			)
 		})
 	}
@@ -403,7 +703,7 @@ func (r *FileRestorer) updateImports() error {
 		specs := make([]dst.Spec, 0, len(block.Specs))
 		for _, spec := range block.Specs {
 			spec := spec.(*dst.ImportSpec)
-			path := mustUnquote(spec.Path.Value)
+			path := importPath(spec)
 			if importsRequired[path] {
 				if spec.Name == nil && aliases[path] != "" {
 					// missing alias
@@ -444,7 +744,7 @@ func (r *FileRestorer) updateImports() error {
 		// import might be deleted.
 		var foundDomainImport bool
 		for _, spec := range blocks[0].Specs {
-			path := mustUnquote(spec.(*dst.ImportSpec).Path.Value)
+			path := importPath(spec.(*dst.ImportSpec))
 			if strings.Contains(path, ".") && !foundDomainImport {
 				// first non-std-lib import -> empty line above
 				spec.Decorations().Before = dst.EmptyLine
@@ -487,14 +787,14 @@ func (r *FileRestorer) updateImports() error {
 func (r *FileRestorer) restoreIdent(n *dst.Ident, parentName, parentField, parentFieldType string, allowDuplicate bool) ast.Node {
 
 	if r.Resolver == nil && n.Path != "" {
-		panic("This syntax has been decorated with import management enabled, but the restorer does not have import management enabled. Use NewRestorerWithImports to create a restorer with import management. See the Imports section of the readme for more information.")
+		panic(&RestoreError{Node: n, Reason: "This syntax has been decorated with import management enabled, but the restorer does not have import management enabled. Use NewRestorerWithImports to create a restorer with import management. See the Imports section of the readme for more information."})
 	}
 
 	var name string
 	if r.Resolver != nil && n.Path != "" {
 
 		if avoid[parentName+"."+parentField] {
-			panic(fmt.Sprintf("Path %s set on illegal Ident %s: parentName %s, parentField %s, parentFieldType %s", n.Path, n.Name, parentName, parentField, parentFieldType))
+			panic(&RestoreError{Node: n, Reason: fmt.Sprintf("Path %s set on illegal Ident %s: parentName %s, parentField %s, parentFieldType %s", n.Path, n.Name, parentName, parentField, parentFieldType)})
 		}
 
 		if n.Path != r.Path {
@@ -668,6 +968,13 @@ func (r *FileRestorer) applyDecorations(node ast.Node, name string, decorations
 		// for newline decorations and also line-comments, add a newline
 		if isLineComment || isNewline {
 			lineOffset := int(r.cursor) - r.base // remember lines are relative to the file base
+			if lineOffset == r.lines[len(r.lines)-1] {
+				// SetLines requires increasing offsets, so if the cursor hasn't moved since the last
+				// line was added (e.g. a newline decoration at the start of the file, where the first
+				// line is at offset 0), move it forward to start a new line.
+				r.cursor++
+				lineOffset++
+			}
 			r.lines = append(r.lines, lineOffset)
 			r.cursor++
 
@@ -760,7 +1067,7 @@ func (r *FileRestorer) restoreObject(o *dst.Object) *ast.Object {
 		r.nodeDecl[out] = decl
 	case nil:
 	default:
-		panic(fmt.Sprintf("o.Decl is %T", o.Decl))
+		panic(&RestoreError{Reason: fmt.Sprintf("unsupported Object.Decl type %T", o.Decl)})
 	}
 
 	switch data := o.Data.(type) {
@@ -774,7 +1081,7 @@ func (r *FileRestorer) restoreObject(o *dst.Object) *ast.Object {
 		r.nodeData[out] = data
 	case nil:
 	default:
-		panic(fmt.Sprintf("o.Data is %T", o.Data))
+		panic(&RestoreError{Reason: fmt.Sprintf("unsupported Object.Data type %T", o.Data)})
 	}
 
 	return out
@@ -814,10 +1121,11 @@ func (r *FileRestorer) restoreScope(s *dst.Scope) *ast.Scope {
 	return out
 }
 
-func mustUnquote(s string) string {
-	out, err := strconv.Unquote(s)
+// importPath returns the unquoted path of an import spec.
+func importPath(spec *dst.ImportSpec) string {
+	out, err := strconv.Unquote(spec.Path.Value)
 	if err != nil {
-		panic(err)
+		panic(&RestoreError{Node: spec, Reason: fmt.Sprintf("invalid import path %s", spec.Path.Value)})
 	}
 	return out
 }
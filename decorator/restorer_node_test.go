@@ -0,0 +1,111 @@
+package decorator
+
+import (
+	"bytes"
+	"go/ast"
+	"go/token"
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator/resolver/goast"
+	"github.com/dave/dst/decorator/resolver/guess"
+)
+
+func TestFprintNode(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   string
+		alias  map[string]string
+		node   func(f *dst.File) dst.Node
+		expect string
+	}{
+		{
+			name: "expr",
+			code: "package a\n\nvar a = b(c /* c */, d)\n",
+			node: func(f *dst.File) dst.Node {
+				n := f.Decls[0].(*dst.GenDecl).Specs[0].(*dst.ValueSpec).Values[0]
+				n.Decorations().Start.Append("/* a */")
+				n.Decorations().End.Append("/* d */")
+				return n
+			},
+			expect: "/* a */ b(c /* c */, d) /* d */",
+		},
+		{
+			name: "stmt",
+			code: "package a\n\nfunc a() {\n\t// a\n\tif b {\n\t\tc() // c\n\t}\n}\n",
+			node: func(f *dst.File) dst.Node {
+				return f.Decls[0].(*dst.FuncDecl).Body.List[0]
+			},
+			expect: "// a\nif b {\n\tc() // c\n}",
+		},
+		{
+			name: "decl",
+			code: "package a\n\n// a\nfunc a() {\n\tb()\n}\n",
+			node: func(f *dst.File) dst.Node {
+				return f.Decls[0]
+			},
+			expect: "// a\nfunc a() {\n\tb()\n}",
+		},
+		{
+			name: "imports",
+			code: "package a\n\nimport (\n\t\"fmt\"\n\tb \"github.com/a/b\"\n)\n\nvar a = fmt.Sprint(b.C)\n",
+			node: func(f *dst.File) dst.Node {
+				return f.Decls[1].(*dst.GenDecl).Specs[0].(*dst.ValueSpec).Values[0]
+			},
+			expect: "fmt.Sprint(b.C)",
+		},
+		{
+			name:  "imports-alias",
+			code:  "package a\n\nimport (\n\t\"fmt\"\n\tb \"github.com/a/b\"\n)\n\nvar a = fmt.Sprint(b.C)\n",
+			alias: map[string]string{"fmt": "f"},
+			node: func(f *dst.File) dst.Node {
+				return f.Decls[1].(*dst.GenDecl).Specs[0].(*dst.ValueSpec).Values[0]
+			},
+			expect: "f.Sprint(b.C)",
+		},
+		{
+			name: "comment",
+			code: "package a\n",
+			node: func(f *dst.File) dst.Node {
+				c := &dst.CommentStmt{}
+				c.Decs.Start.Append("// a", "// b")
+				return c
+			},
+			expect: "// a\n// b",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := NewDecoratorWithImports(nil, "a", goast.New())
+			f, err := d.Parse(test.code)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := NewRestorerWithImports("a", guess.New()).FileRestorer()
+			for k, v := range test.alias {
+				r.Alias[k] = v
+			}
+			buf := &bytes.Buffer{}
+			if err := r.FprintNode(buf, test.node(f)); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != test.expect {
+				t.Errorf("diff:\n%s", diff(test.expect, buf.String()))
+			}
+		})
+	}
+}
+
+func TestRestoreNode(t *testing.T) {
+	n, err := NewRestorer().RestoreNode(&dst.BinaryExpr{X: dst.NewIdent("a"), Op: token.ADD, Y: dst.NewIdent("b")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	be, ok := n.(*ast.BinaryExpr)
+	if !ok {
+		t.Fatalf("expected *ast.BinaryExpr, found %T", n)
+	}
+	if be.X.(*ast.Ident).Name != "a" || be.Y.(*ast.Ident).Name != "b" {
+		t.Error("unexpected restored node")
+	}
+}
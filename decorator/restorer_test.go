@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"go/format"
 	"testing"
+
+	"github.com/dave/dst"
 )
 
 func TestRestorer(t *testing.T) {
@@ -783,3 +785,19 @@ const d = 1
 		})
 	}
 }
+
+func TestRestorer_NewlineAtStart(t *testing.T) {
+	// A newline decoration before the package clause used to add a second line at offset 0, which
+	// made SetLines fail with "invalid line offsets".
+	for _, decs := range []dst.Decorations{{"\n"}, {"\n", "// a"}, {"\n", "\n", "// a"}} {
+		f := &dst.File{Name: dst.NewIdent("a")}
+		f.Decs.Start = decs
+		buf := &bytes.Buffer{}
+		if err := Fprint(buf, f); err != nil {
+			t.Fatalf("%q: %v", decs, err)
+		}
+		if _, err := Parse(buf.String()); err != nil {
+			t.Fatalf("%q: %v", decs, err)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package decorator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dave/dst"
+)
+
+// NewSnippetDecorator returns a new decorator with import management enabled for parsing snippets
+// with ParseExpr, ParseStmts, ParseDecls and ParseType. Path is the path of the package the
+// snippets will be used in. Imports is a map of alias -> package path: qualified identifiers
+// using these aliases are decorated as *dst.Ident with Path set.
+func NewSnippetDecorator(path string, imports map[string]string) *Decorator {
+	dec := NewDecoratorWithImports(nil, path, nil)
+	dec.Resolver = snippetResolver{dec}
+	dec.Imports = imports
+	return dec
+}
+
+// snippetResolver resolves qualified identifiers using the aliases in the Imports map of the
+// decorator. The goast resolver does the same using the imports block, but it can't be used here
+// because its tests import this package.
+type snippetResolver struct {
+	d *Decorator
+}
+
+func (r snippetResolver) ResolveIdent(file *ast.File, parent ast.Node, parentField string, id *ast.Ident) (string, error) {
+	se, ok := parent.(*ast.SelectorExpr)
+	if !ok || parentField != "Sel" {
+		return "", nil
+	}
+	xid, ok := se.X.(*ast.Ident)
+	if !ok || xid.Obj != nil {
+		// Obj != nil -> not a qualified ident
+		return "", nil
+	}
+	return r.d.Imports[xid.Name], nil
+}
+
+// ParseExpr parses and decorates a Go expression. Comments before and after the expression are
+// attached to the Start and End decorations of the returned node.
+func (d *Decorator) ParseExpr(src string) (dst.Expr, error) {
+	f, err := d.parseSnippet("func _() {\n_ =\n", src, "\n}")
+	if err != nil {
+		return nil, err
+	}
+	if len(f.Decls) != 1 {
+		return nil, fmt.Errorf("expected a single expression")
+	}
+	block := f.Decls[0].(*dst.FuncDecl).Body
+	if len(block.List) != 1 {
+		return nil, fmt.Errorf("expected a single expression")
+	}
+	assign, ok := block.List[0].(*dst.AssignStmt)
+	if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil, fmt.Errorf("expected a single expression")
+	}
+	out := assign.Rhs[0]
+	assign.Rhs = nil
+	out.Decorations().Start.Prepend(assign.Decs.Tok...)
+	out.Decorations().End.Append(assign.Decs.End...)
+	out.Decorations().Before = dst.None
+	out.Decorations().After = dst.None
+	return out, nil
+}
+
+// ParseType parses and decorates a Go type expression. Comments before and after the type are
+// attached to the Start and End decorations of the returned node.
+func (d *Decorator) ParseType(src string) (dst.Expr, error) {
+	out, err := d.ParseExpr(src)
+	if err != nil {
+		return nil, err
+	}
+	if !isType(out) {
+		return nil, fmt.Errorf("expected a type, found %T", out)
+	}
+	return out, nil
+}
+
+// ParseStmts parses and decorates a list of Go statements.
+func (d *Decorator) ParseStmts(src string) ([]dst.Stmt, error) {
+	f, err := d.parseSnippet("func _() {\n", src, "\n}")
+	if err != nil {
+		return nil, err
+	}
+	if len(f.Decls) != 1 {
+		return nil, fmt.Errorf("expected a list of statements")
+	}
+	block := f.Decls[0].(*dst.FuncDecl).Body
+	out := block.List
+	block.List = nil
+	return out, nil
+}
+
+// ParseDecls parses and decorates a list of Go declarations.
+func (d *Decorator) ParseDecls(src string) ([]dst.Decl, error) {
+	f, err := d.parseSnippet("", src, "\n")
+	if err != nil {
+		return nil, err
+	}
+	out := f.Decls
+	f.Decls = nil
+	return out, nil
+}
+
+// parseSnippet wraps the source in a file, parses and decorates it. The generated import block is
+// removed from the Decls of the returned file.
+func (d *Decorator) parseSnippet(prefix, src, suffix string) (*dst.File, error) {
+	header := "package p\n\n" + d.importsBlock()
+	code := header + prefix + src + suffix
+
+	f, err := parser.ParseFile(d.Fset, "", code, parser.ParseComments)
+	if err != nil {
+		return nil, snippetError(err, strings.Count(header+prefix, "\n"))
+	}
+	file, err := d.DecorateFile(f)
+	if err != nil {
+		return nil, err
+	}
+	delete(d.Filenames, file)
+	if len(d.Imports) > 0 {
+		file.Decls = file.Decls[1:]
+	}
+	return file, nil
+}
+
+func (d *Decorator) importsBlock() string {
+	if len(d.Imports) == 0 {
+		return ""
+	}
+	var aliases []string
+	for alias := range d.Imports {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	sb := &strings.Builder{}
+	sb.WriteString("import (\n")
+	for _, alias := range aliases {
+		fmt.Fprintf(sb, "%s %s\n", alias, strconv.Quote(d.Imports[alias]))
+	}
+	sb.WriteString(")\n\n")
+	return sb.String()
+}
+
+// snippetError adjusts the line numbers in parser errors so they are relative to the start of the
+// snippet.
+func snippetError(err error, lines int) error {
+	list, ok := err.(scanner.ErrorList)
+	if !ok {
+		return err
+	}
+	out := make(scanner.ErrorList, len(list))
+	for i, e := range list {
+		pos := e.Pos
+		pos.Line -= lines
+		out[i] = &scanner.Error{Pos: pos, Msg: e.Msg}
+	}
+	return out
+}
+
+func isType(e dst.Expr) bool {
+	switch e := e.(type) {
+	case *dst.Ident, *dst.SelectorExpr, *dst.ArrayType, *dst.StructType, *dst.FuncType,
+		*dst.InterfaceType, *dst.MapType, *dst.ChanType, *dst.IndexExpr, *dst.IndexListExpr:
+		return true
+	case *dst.StarExpr:
+		return isType(e.X)
+	case *dst.ParenExpr:
+		return isType(e.X)
+	}
+	return false
+}
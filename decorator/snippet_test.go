@@ -0,0 +1,130 @@
+package decorator
+
+import (
+	"bytes"
+	"go/scanner"
+	"go/token"
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator/resolver/guess"
+)
+
+func TestParseSnippets(t *testing.T) {
+	tests := []struct {
+		name    string
+		imports map[string]string
+		parse   func(d *Decorator) ([]dst.Decl, error)
+		expect  string
+	}{
+		{
+			name: "expr",
+			parse: func(d *Decorator) ([]dst.Decl, error) {
+				e, err := d.ParseExpr("/* a */ b + c /* d */")
+				if err != nil {
+					return nil, err
+				}
+				return []dst.Decl{varDecl(e)}, nil
+			},
+			expect: "var _ = /* a */ b + c /* d */\n",
+		},
+		{
+			name: "expr-line-comment",
+			parse: func(d *Decorator) ([]dst.Decl, error) {
+				e, err := d.ParseExpr("// a\nb(\n\tc,\n) // d")
+				if err != nil {
+					return nil, err
+				}
+				return []dst.Decl{varDecl(e)}, nil
+			},
+			expect: "var _ = // a\nb(\n\tc,\n) // d\n",
+		},
+		{
+			name:    "expr-imports",
+			imports: map[string]string{"f": "fmt", "bar": "github.com/foo/bar"},
+			parse: func(d *Decorator) ([]dst.Decl, error) {
+				e, err := d.ParseExpr(`f.Sprint(bar.Baz)`)
+				if err != nil {
+					return nil, err
+				}
+				return []dst.Decl{varDecl(e)}, nil
+			},
+			expect: "import (\n\t\"fmt\"\n\n\t\"github.com/foo/bar\"\n)\n\nvar _ = fmt.Sprint(bar.Baz)\n",
+		},
+		{
+			name: "type",
+			parse: func(d *Decorator) ([]dst.Decl, error) {
+				e, err := d.ParseType("map[string]*struct{ a int } // b")
+				if err != nil {
+					return nil, err
+				}
+				return []dst.Decl{&dst.GenDecl{Tok: token.VAR, Specs: []dst.Spec{&dst.ValueSpec{Names: []*dst.Ident{dst.NewIdent("_")}, Type: e}}}}, nil
+			},
+			expect: "var _ map[string]*struct{ a int } // b\n",
+		},
+		{
+			name: "stmts",
+			parse: func(d *Decorator) ([]dst.Decl, error) {
+				s, err := d.ParseStmts("// a\na := 1\n\nb(a) // b")
+				if err != nil {
+					return nil, err
+				}
+				return []dst.Decl{&dst.FuncDecl{Name: dst.NewIdent("f"), Type: &dst.FuncType{Func: true}, Body: &dst.BlockStmt{List: s}}}, nil
+			},
+			expect: "func f() {\n\t// a\n\ta := 1\n\n\tb(a) // b\n}\n",
+		},
+		{
+			name:    "decls",
+			imports: map[string]string{"fmt": "fmt"},
+			parse: func(d *Decorator) ([]dst.Decl, error) {
+				return d.ParseDecls("// a\nvar a = 1\n\n// b\nfunc b() { fmt.Println(a) }")
+			},
+			expect: "import \"fmt\"\n\n// a\nvar a = 1\n\n// b\nfunc b() { fmt.Println(a) }\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := NewDecorator(nil)
+			if test.imports != nil {
+				d = NewSnippetDecorator("a", test.imports)
+			}
+			decls, err := test.parse(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, decl := range decls {
+				decl.Decorations().Before = dst.EmptyLine
+			}
+			f := &dst.File{Name: dst.NewIdent("a"), Decls: decls}
+			buf := &bytes.Buffer{}
+			if err := NewRestorerWithImports("a", guess.New()).Fprint(buf, f); err != nil {
+				t.Fatal(err)
+			}
+			expect := "package a\n\n" + test.expect
+			if buf.String() != expect {
+				t.Errorf("diff:\n%s", diff(expect, buf.String()))
+			}
+		})
+	}
+}
+
+func varDecl(e dst.Expr) *dst.GenDecl {
+	return &dst.GenDecl{Tok: token.VAR, Specs: []dst.Spec{&dst.ValueSpec{Names: []*dst.Ident{dst.NewIdent("_")}, Values: []dst.Expr{e}}}}
+}
+
+func TestParseSnippetErrors(t *testing.T) {
+	if _, err := ParseExpr("a, b"); err == nil || err.Error() != "expected a single expression" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := ParseType("a + b"); err == nil || err.Error() != "expected a type, found *dst.BinaryExpr" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	_, err := ParseStmts("a := 1\nb(")
+	list, ok := err.(scanner.ErrorList)
+	if !ok {
+		t.Fatalf("expected scanner.ErrorList, found %T", err)
+	}
+	if list[0].Pos.Line != 3 {
+		t.Errorf("expected error on line 3, found %s", list[0])
+	}
+}
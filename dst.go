@@ -370,6 +370,15 @@ type (
 		Decs     EmptyStmtDecorations
 	}
 
+	// A CommentStmt node represents a free-floating comment in a statement list (e.g. a comment
+	// separated from the statements around it by empty lines). The comments are in the Start
+	// decorations. CommentStmt has no go/ast equivalent: the restorer adds the comments to the
+	// output without creating a statement. See decorator.AttachmentPolicy.
+	//
+	CommentStmt struct {
+		Decs CommentStmtDecorations
+	}
+
 	// A LabeledStmt node represents a labeled statement.
 	LabeledStmt struct {
 		Label *Ident
@@ -512,6 +521,7 @@ type (
 func (*BadStmt) stmtNode()        {}
 func (*DeclStmt) stmtNode()       {}
 func (*EmptyStmt) stmtNode()      {}
+func (*CommentStmt) stmtNode()    {}
 func (*LabeledStmt) stmtNode()    {}
 func (*ExprStmt) stmtNode()       {}
 func (*SendStmt) stmtNode()       {}
@@ -589,6 +599,15 @@ type (
 		Decs   BadDeclDecorations
 	}
 
+	// A CommentDecl node represents a free-floating comment in the declarations of a file (e.g.
+	// a comment separated from the declarations around it by empty lines). The comments are in
+	// the Start decorations. CommentDecl has no go/ast equivalent: the restorer adds the comments
+	// to the output without creating a declaration. See decorator.AttachmentPolicy.
+	//
+	CommentDecl struct {
+		Decs CommentDeclDecorations
+	}
+
 	// A GenDecl node (generic declaration node) represents an import,
 	// constant, type or variable declaration. A valid Lparen position
 	// (Lparen.IsValid()) indicates a parenthesized declaration.
@@ -620,9 +639,10 @@ type (
 
 // declNode() ensures that only declaration nodes can be
 // assigned to a Decl.
-func (*BadDecl) declNode()  {}
-func (*GenDecl) declNode()  {}
-func (*FuncDecl) declNode() {}
+func (*BadDecl) declNode()     {}
+func (*CommentDecl) declNode() {}
+func (*GenDecl) declNode()     {}
+func (*FuncDecl) declNode()    {}
 
 // ----------------------------------------------------------------------------
 // Files and packages
@@ -0,0 +1,172 @@
+// Package analysisfix allows go/analysis analyzers to express fixes by modifying dst nodes instead of
+// writing byte offset text edits. Inside the Run function of an analyzer, New decorates the files
+// of the pass. The analyzer modifies the decorated files, and Report (or SuggestedFix) converts the
+// difference between the original and restored files into text edits:
+//
+//	func run(pass *analysis.Pass) (interface{}, error) {
+//		p, err := analysisfix.New(pass)
+//		if err != nil {
+//			return nil, err
+//		}
+//		for _, f := range p.Files {
+//			dst.Inspect(f, func(n dst.Node) bool {
+//				if id, ok := n.(*dst.Ident); ok && id.Name == "Foo" {
+//					id.Name = "Bar"
+//					if err := p.Report(id, "use Bar instead of Foo"); err != nil {
+//						...
+//					}
+//				}
+//				return true
+//			})
+//		}
+//		return nil, nil
+//	}
+package analysisfix
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"io/ioutil"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+	"github.com/dave/dst/decorator/resolver/gotypes"
+	"github.com/dave/dst/decorator/resolver/guess"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Pass holds the decorated files of an analysis pass.
+type Pass struct {
+	Pass      *analysis.Pass
+	Decorator *decorator.Decorator
+	Files     []*dst.File // The decorated files, in the same order as Pass.Files
+	files     map[*dst.File]*file
+}
+
+type file struct {
+	token    *token.File
+	src      []byte        // the original source
+	reported map[edit]bool // edits returned by previous calls to SuggestedFix
+}
+
+type edit struct {
+	pos, end token.Pos
+	text     string
+}
+
+// New decorates the files of pass. Qualified identifiers are resolved using the type information
+// of the pass (in the same way as decorator.NewDecoratorFromPackage), so the imports of the files
+// are updated when they are restored. The original source of the files is read from disk.
+func New(pass *analysis.Pass) (*Pass, error) {
+	p := &Pass{
+		Pass:      pass,
+		Decorator: decorator.NewDecoratorWithImports(pass.Fset, pass.Pkg.Path(), gotypes.New(pass.TypesInfo.Uses)),
+		files:     map[*dst.File]*file{},
+	}
+	for _, af := range pass.Files {
+		tf := pass.Fset.File(af.Pos())
+		src, err := ioutil.ReadFile(tf.Name())
+		if err != nil {
+			return nil, err
+		}
+		f, err := p.Decorator.DecorateFile(af)
+		if err != nil {
+			return nil, err
+		}
+		p.Files = append(p.Files, f)
+		p.files[f] = &file{token: tf, src: src, reported: map[edit]bool{}}
+	}
+	return p, nil
+}
+
+// SuggestedFix restores the files and returns a SuggestedFix with the text edits that change the
+// original source into the restored source. Only the changed top level declarations are restored
+// (see decorator.FileRestorer.FprintMinimal), so code that wasn't changed isn't reformatted. Edits
+// returned by previous calls are omitted, so each fix only contains the changes made since the
+// previous call. Changes on the same line as an earlier change are combined with it, so the edits
+// may overlap the edits of an earlier fix.
+func (p *Pass) SuggestedFix(message string) (analysis.SuggestedFix, error) {
+	fix := analysis.SuggestedFix{Message: message}
+	for _, f := range p.Files {
+		info := p.files[f]
+		buf := &bytes.Buffer{}
+		r := decorator.NewRestorerWithImports(p.Pass.Pkg.Path(), p.resolver())
+		if err := r.FprintMinimal(buf, p.Decorator, f, info.src); err != nil {
+			return analysis.SuggestedFix{}, err
+		}
+		for _, e := range textEdits(info.token, info.src, buf.Bytes()) {
+			key := edit{e.Pos, e.End, string(e.NewText)}
+			if info.reported[key] {
+				continue
+			}
+			info.reported[key] = true
+			fix.TextEdits = append(fix.TextEdits, e)
+		}
+	}
+	return fix, nil
+}
+
+// Report reports a diagnostic for n with a SuggestedFix containing the changes made since the
+// previous call to Report or SuggestedFix. The position of the diagnostic is the position of n in
+// the original source. If n was added to the files, the position of its nearest ancestor from the
+// original source is used, and if n isn't in the files an error is returned.
+func (p *Pass) Report(n dst.Node, message string) error {
+	pos, end, err := p.position(n)
+	if err != nil {
+		return err
+	}
+	fix, err := p.SuggestedFix(message)
+	if err != nil {
+		return err
+	}
+	d := analysis.Diagnostic{Pos: pos, End: end, Message: message}
+	if len(fix.TextEdits) > 0 {
+		d.SuggestedFixes = []analysis.SuggestedFix{fix}
+	}
+	p.Pass.Report(d)
+	return nil
+}
+
+// position returns the position of n in the original source, or the position of the nearest
+// ancestor of n that was decorated from the original source.
+func (p *Pass) position(n dst.Node) (pos, end token.Pos, err error) {
+	if an, ok := p.Decorator.Ast.Nodes[n]; ok {
+		return an.Pos(), an.End(), nil
+	}
+	for _, f := range p.Files {
+		var stack, ancestors []dst.Node
+		var found bool
+		dst.Inspect(f, func(c dst.Node) bool {
+			switch {
+			case c == nil:
+				stack = stack[:len(stack)-1]
+				return false
+			case found:
+				return false
+			case c == n:
+				found = true
+				ancestors = append(ancestors, stack...)
+				return false
+			}
+			stack = append(stack, c)
+			return true
+		})
+		for i := len(ancestors) - 1; i >= 0; i-- {
+			if an, ok := p.Decorator.Ast.Nodes[ancestors[i]]; ok {
+				return an.Pos(), an.End(), nil
+			}
+		}
+	}
+	return token.NoPos, token.NoPos, fmt.Errorf("%T isn't in the files of the pass", n)
+}
+
+// resolver resolves the names of packages imported by the pass from the type information, and
+// guesses the names of any other packages.
+func (p *Pass) resolver() guess.RestorerResolver {
+	names := map[string]string{}
+	for _, imp := range p.Pass.Pkg.Imports() {
+		names[imp.Path()] = imp.Name()
+	}
+	return guess.WithMap(names)
+}
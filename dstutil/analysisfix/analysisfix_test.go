@@ -0,0 +1,203 @@
+package analysisfix_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/dstutil/analysisfix"
+	"golang.org/x/tools/go/analysis"
+)
+
+func TestReport(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   string
+		mutate func(t *testing.T, p *analysisfix.Pass)
+		expect []string // the source after applying each reported fix in turn
+	}{
+		{
+			name: "rename",
+			code: "package a\n\nfunc a() {\n\tb() // b\n}\n\nfunc b() {}\n",
+			mutate: func(t *testing.T, p *analysisfix.Pass) {
+				id := p.Files[0].Decls[0].(*dst.FuncDecl).Body.List[0].(*dst.ExprStmt).X.(*dst.CallExpr).Fun.(*dst.Ident)
+				id.Name = "c"
+				report(t, p, id, "rename")
+			},
+			expect: []string{"package a\n\nfunc a() {\n\tc() // b\n}\n\nfunc b() {}\n"},
+		},
+		{
+			name: "add-import",
+			code: "package a\n\nfunc a() {\n}\n",
+			mutate: func(t *testing.T, p *analysisfix.Pass) {
+				fd := p.Files[0].Decls[0].(*dst.FuncDecl)
+				fd.Body.List = append(fd.Body.List, &dst.ExprStmt{
+					X: &dst.CallExpr{Fun: &dst.Ident{Name: "Println", Path: "fmt"}},
+				})
+				report(t, p, fd, "print")
+			},
+			expect: []string{"package a\n\nimport \"fmt\"\n\nfunc a() {\n\tfmt.Println()\n}\n"},
+		},
+		{
+			name: "separate-fixes",
+			code: "package a\n\nvar a = 1\n\nvar b = 2\n",
+			mutate: func(t *testing.T, p *analysisfix.Pass) {
+				a := p.Files[0].Decls[0].(*dst.GenDecl).Specs[0].(*dst.ValueSpec).Values[0].(*dst.BasicLit)
+				a.Value = "3"
+				report(t, p, a, "a")
+				b := p.Files[0].Decls[1].(*dst.GenDecl).Specs[0].(*dst.ValueSpec).Values[0].(*dst.BasicLit)
+				b.Value = "4"
+				report(t, p, b, "b")
+			},
+			expect: []string{
+				"package a\n\nvar a = 3\n\nvar b = 2\n",
+				"package a\n\nvar a = 1\n\nvar b = 4\n",
+			},
+		},
+		{
+			name: "no-change",
+			code: "package a\n\nvar a = 1\n",
+			mutate: func(t *testing.T, p *analysisfix.Pass) {
+				report(t, p, p.Files[0].Decls[0], "none")
+			},
+			expect: []string{"package a\n\nvar a = 1\n"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pass, src := newPass(t, test.code)
+			var diagnostics []analysis.Diagnostic
+			pass.Report = func(d analysis.Diagnostic) { diagnostics = append(diagnostics, d) }
+			p, err := analysisfix.New(pass)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.mutate(t, p)
+			if len(diagnostics) != len(test.expect) {
+				t.Fatalf("expected %d diagnostics, found %d", len(test.expect), len(diagnostics))
+			}
+			tf := pass.Fset.File(pass.Files[0].Pos())
+			for i, d := range diagnostics {
+				if !d.Pos.IsValid() {
+					t.Errorf("diagnostic %d has no position", i)
+				}
+				var edits []analysis.TextEdit
+				for _, fix := range d.SuggestedFixes {
+					edits = append(edits, fix.TextEdits...)
+				}
+				if found := apply(tf, src, edits); found != test.expect[i] {
+					t.Errorf("diagnostic %d: expected:\n%s\nfound:\n%s", i, test.expect[i], found)
+				}
+			}
+		})
+	}
+}
+
+func TestSuggestedFixMinimal(t *testing.T) {
+	pass, _ := newPass(t, "package a\n\nvar a = []int{1, 2, 3}\n")
+	p, err := analysisfix.New(pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lit := p.Files[0].Decls[0].(*dst.GenDecl).Specs[0].(*dst.ValueSpec).Values[0].(*dst.CompositeLit)
+	lit.Elts[1].(*dst.BasicLit).Value = "4"
+	fix, err := p.SuggestedFix("change")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fix.TextEdits) != 1 {
+		t.Fatalf("expected 1 edit, found %d", len(fix.TextEdits))
+	}
+	e := fix.TextEdits[0]
+	if e.End-e.Pos != 1 || string(e.NewText) != "4" {
+		t.Errorf("expected one byte replaced with \"4\", found %d bytes replaced with %q", e.End-e.Pos, e.NewText)
+	}
+}
+
+func TestSuggestedFixUnformatted(t *testing.T) {
+	code := "package a\n\nvar a = []int{1,2}\n\nvar b = 1\n"
+	pass, src := newPass(t, code)
+	p, err := analysisfix.New(pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Files[0].Decls[1].(*dst.GenDecl).Specs[0].(*dst.ValueSpec).Values[0].(*dst.BasicLit).Value = "2"
+	fix, err := p.SuggestedFix("change")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the unformatted declaration of a is left as it was
+	expect := "package a\n\nvar a = []int{1,2}\n\nvar b = 2\n"
+	if found := apply(pass.Fset.File(pass.Files[0].Pos()), src, fix.TextEdits); found != expect {
+		t.Errorf("expected:\n%s\nfound:\n%s", expect, found)
+	}
+}
+
+func TestReportNewNode(t *testing.T) {
+	pass, _ := newPass(t, "package a\n\nfunc a() {\n}\n")
+	var diagnostics []analysis.Diagnostic
+	pass.Report = func(d analysis.Diagnostic) { diagnostics = append(diagnostics, d) }
+	p, err := analysisfix.New(pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd := p.Files[0].Decls[0].(*dst.FuncDecl)
+	call := &dst.CallExpr{Fun: dst.NewIdent("b")}
+	fd.Body.List = append(fd.Body.List, &dst.ExprStmt{X: call})
+	report(t, p, call, "added")
+	if len(diagnostics) != 1 {
+		t.Fatalf("expected 1 diagnostic, found %d", len(diagnostics))
+	}
+	body := pass.Files[0].Decls[0].(*ast.FuncDecl).Body
+	if d := diagnostics[0]; d.Pos != body.Pos() || d.End != body.End() {
+		t.Errorf("expected the position of the block, found %v-%v", d.Pos, d.End)
+	}
+
+	if err := p.Report(dst.NewIdent("c"), "missing"); err == nil {
+		t.Error("expected an error for a node that isn't in the files")
+	}
+	if len(diagnostics) != 1 {
+		t.Errorf("expected no diagnostic for a node that isn't in the files, found %d", len(diagnostics))
+	}
+}
+
+func report(t *testing.T, p *analysisfix.Pass, n dst.Node, message string) {
+	t.Helper()
+	if err := p.Report(n, message); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// newPass writes code to a temporary file, and returns a type checked pass for it.
+func newPass(t *testing.T, code string) (*analysis.Pass, string) {
+	t.Helper()
+	fpath := filepath.Join(t.TempDir(), "a.go")
+	if err := os.WriteFile(fpath, []byte(code), 0666); err != nil {
+		t.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, fpath, nil, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := &types.Info{Uses: map[*ast.Ident]types.Object{}}
+	pkg, err := (&types.Config{}).Check("a", fset, []*ast.File{f}, info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &analysis.Pass{Fset: fset, Files: []*ast.File{f}, Pkg: pkg, TypesInfo: info}, code
+}
+
+func apply(tf *token.File, src string, edits []analysis.TextEdit) string {
+	sort.Slice(edits, func(i, j int) bool { return edits[i].Pos > edits[j].Pos })
+	for _, e := range edits {
+		src = src[:tf.Offset(e.Pos)] + string(e.NewText) + src[tf.Offset(e.End):]
+	}
+	return src
+}
@@ -0,0 +1,78 @@
+package analysisfix
+
+import (
+	"go/token"
+	"strings"
+
+	"github.com/dave/dst/internal/linediff"
+	"github.com/sergi/go-diff/diffmatchpatch"
+	"golang.org/x/tools/go/analysis"
+)
+
+// textEdits returns the text edits that change before into after. Changed lines are found with
+// a line diff, and each hunk is reduced to the bytes that actually change.
+func textEdits(tf *token.File, before, after []byte) []analysis.TextEdit {
+	ops := linediff.Diff(string(before), string(after))
+
+	var edits []analysis.TextEdit
+	var offset int         // the offset in before
+	var start int          // the offset in before of the current hunk
+	var old, text []string // the deleted and inserted lines of the current hunk
+	flush := func() {
+		if old == nil && text == nil {
+			return
+		}
+		o, t := strings.Join(old, ""), strings.Join(text, "")
+		prefix := commonPrefix(o, t)
+		o, t = o[prefix:], t[prefix:]
+		suffix := commonSuffix(o, t)
+		o, t = o[:len(o)-suffix], t[:len(t)-suffix]
+		old, text = nil, nil
+		if o == "" && t == "" {
+			return
+		}
+		edits = append(edits, analysis.TextEdit{
+			Pos:     tf.Pos(start + prefix),
+			End:     tf.Pos(start + prefix + len(o)),
+			NewText: []byte(t),
+		})
+	}
+	for _, op := range ops {
+		for _, line := range op.Lines {
+			switch op.Type {
+			case diffmatchpatch.DiffEqual:
+				flush()
+				offset += len(line)
+			case diffmatchpatch.DiffDelete:
+				if old == nil && text == nil {
+					start = offset
+				}
+				old = append(old, line)
+				offset += len(line)
+			case diffmatchpatch.DiffInsert:
+				if old == nil && text == nil {
+					start = offset
+				}
+				text = append(text, line)
+			}
+		}
+	}
+	flush()
+	return edits
+}
+
+func commonPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+func commonSuffix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[len(a)-1-i] == b[len(b)-1-i] {
+		i++
+	}
+	return i
+}
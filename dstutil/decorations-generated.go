@@ -86,6 +86,16 @@ func decorations(n dst.Node) (before, after dst.SpaceType, points []DecorationPo
 		points = append(points, DecorationPoint{"Comm", n.Decs.Comm})
 		points = append(points, DecorationPoint{"Colon", n.Decs.Colon})
 		points = append(points, DecorationPoint{"End", n.Decs.End})
+	case *dst.CommentDecl:
+		before = n.Decs.Before
+		after = n.Decs.After
+		points = append(points, DecorationPoint{"Start", n.Decs.Start})
+		points = append(points, DecorationPoint{"End", n.Decs.End})
+	case *dst.CommentStmt:
+		before = n.Decs.Before
+		after = n.Decs.After
+		points = append(points, DecorationPoint{"Start", n.Decs.Start})
+		points = append(points, DecorationPoint{"End", n.Decs.End})
 	case *dst.CompositeLit:
 		before = n.Decs.Before
 		after = n.Decs.After
@@ -0,0 +1,101 @@
+package dstutil
+
+import (
+	"strings"
+
+	"github.com/dave/dst"
+)
+
+// Directives returns the directive comments (e.g. "//go:noinline", "//nolint:errcheck" or
+// "//export foo") in the Start and End decorations of n. See dst.Decoration.IsDirective for the
+// comments that are directives.
+func Directives(n dst.Node) []string {
+	decs := n.Decorations()
+	if decs == nil {
+		return nil
+	}
+	var directives []string
+	for _, d := range [][]dst.Decoration{decs.Start.Entries(), decs.End.Entries()} {
+		for _, e := range d {
+			if e.IsDirective() {
+				directives = append(directives, e.Strings()[0])
+			}
+		}
+	}
+	return directives
+}
+
+// HasDirective returns true if n has a directive that matches name. See RemoveDirective for the
+// matching rules.
+func HasDirective(n dst.Node, name string) bool {
+	for _, d := range Directives(n) {
+		if matchDirective(d, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddDirective adds a directive comment (e.g. "//go:noinline" or "go:noinline") to the end of the
+// Start decorations of n, so it's printed directly above the node. An empty line at the end of the
+// Start decorations is kept, so a comment separated from the node stays separated and the
+// directive is added after the empty line. If n already has the directive, nothing is changed.
+func AddDirective(n dst.Node, directive string) {
+	if !strings.HasPrefix(directive, "//") {
+		directive = "//" + directive
+	}
+	decs := n.Decorations()
+	if decs == nil {
+		return
+	}
+	for _, d := range Directives(n) {
+		if d == directive {
+			return
+		}
+	}
+	entries := decs.Start.Entries()
+	if len(entries) > 0 && entries[len(entries)-1].Kind != dst.NewlineDecoration {
+		// a block comment without a newline would be printed on the same line as the directive
+		entries[len(entries)-1].Newline = true
+	}
+	entries = append(entries, dst.Decoration{Kind: dst.LineComment, Text: directive[2:], Newline: true})
+	decs.Start.SetEntries(entries...)
+}
+
+// RemoveDirective removes the directives that match name from the Start and End decorations of n,
+// and returns true if any were removed. A directive matches if the text after "//" is name, or
+// starts with name followed by a space or a colon, so "nolint" matches "//nolint:errcheck" and
+// "go:generate" matches "//go:generate stringer -type=T".
+func RemoveDirective(n dst.Node, name string) bool {
+	decs := n.Decorations()
+	if decs == nil {
+		return false
+	}
+	var removed bool
+	for _, d := range []*dst.Decorations{&decs.Start, &decs.End} {
+		var entries []dst.Decoration
+		var changed bool
+		for _, e := range d.Entries() {
+			if e.IsDirective() && matchDirective(e.Strings()[0], name) {
+				changed = true
+				continue
+			}
+			entries = append(entries, e)
+		}
+		if changed {
+			d.SetEntries(entries...)
+			removed = true
+		}
+	}
+	return removed
+}
+
+func matchDirective(directive, name string) bool {
+	name = strings.TrimPrefix(name, "//")
+	text := strings.TrimPrefix(strings.TrimPrefix(directive, "/*"), "//")
+	if !strings.HasPrefix(text, name) {
+		return false
+	}
+	rest := text[len(name):]
+	return rest == "" || rest == "*/" || rest[0] == ' ' || rest[0] == ':'
+}
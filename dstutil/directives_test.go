@@ -0,0 +1,66 @@
+package dstutil_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+	"github.com/dave/dst/dstutil"
+)
+
+func TestDirectives(t *testing.T) {
+	code := "package a\n\n// a does b\n//go:noinline\n//nolint:errcheck\nfunc a() {}\n\n// c\n\nfunc c() {} //go:x\n"
+	f, err := decorator.Parse(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, c := f.Decls[0], f.Decls[1]
+
+	if found := fmt.Sprint(dstutil.Directives(a)); found != "[//go:noinline //nolint:errcheck]" {
+		t.Fatalf("unexpected directives %s", found)
+	}
+	if !dstutil.HasDirective(a, "nolint") || dstutil.HasDirective(a, "go:noin") || dstutil.HasDirective(c, "go:noinline") {
+		t.Fatal("unexpected HasDirective result")
+	}
+
+	if !dstutil.RemoveDirective(a, "nolint") || dstutil.RemoveDirective(a, "nolint") {
+		t.Fatal("unexpected RemoveDirective result")
+	}
+	dstutil.AddDirective(a, "go:noinline")
+	dstutil.AddDirective(a, "//export a")
+	dstutil.AddDirective(c, "go:generate stringer")
+	if !dstutil.RemoveDirective(c, "go:x") {
+		t.Fatal("expected go:x to be removed")
+	}
+
+	buf := &bytes.Buffer{}
+	if err := decorator.Fprint(buf, f); err != nil {
+		t.Fatal(err)
+	}
+	expected := "package a\n\n// a does b\n//go:noinline\n//export a\nfunc a() {}\n\n// c\n\n//go:generate stringer\nfunc c() {}\n"
+	if buf.String() != expected {
+		t.Fatalf("expected:\n%s\nfound:\n%s", expected, buf.String())
+	}
+
+	if dstutil.Directives(&dst.Package{}) != nil {
+		t.Fatal("expected no directives for Package")
+	}
+}
+
+func TestAddDirective_BlockComment(t *testing.T) {
+	f, err := decorator.Parse("package a\n\n/* a */ var a int\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dstutil.AddDirective(f.Decls[0], "//go:embed a.txt")
+	buf := &bytes.Buffer{}
+	if err := decorator.Fprint(buf, f); err != nil {
+		t.Fatal(err)
+	}
+	expected := "package a\n\n/* a */\n//go:embed a.txt\nvar a int\n"
+	if buf.String() != expected {
+		t.Fatalf("expected:\n%s\nfound:\n%s", expected, buf.String())
+	}
+}
@@ -0,0 +1,1024 @@
+package dstutil
+
+import (
+	"fmt"
+	"github.com/dave/dst"
+)
+
+func (p *goPrinter) node(n dst.Node) {
+	if p.nilNode(n) {
+		return
+	}
+	switch n := n.(type) {
+	case *dst.ArrayType:
+		p.open("&dst.ArrayType{")
+
+		// Node: Len
+		if n.Len != nil {
+			p.key("Len")
+			p.node(n.Len)
+		}
+
+		// Node: Elt
+		if n.Elt != nil {
+			p.key("Elt")
+			p.node(n.Elt)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.AssignStmt:
+		p.open("&dst.AssignStmt{")
+
+		// List: Lhs
+		if len(n.Lhs) > 0 {
+			p.key("Lhs")
+			p.open("[]dst.Expr{")
+			for _, v := range n.Lhs {
+				p.elem(false)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		// Token: Tok
+		p.value("Tok", n.Tok)
+
+		// List: Rhs
+		if len(n.Rhs) > 0 {
+			p.key("Rhs")
+			p.open("[]dst.Expr{")
+			for _, v := range n.Rhs {
+				p.elem(false)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.BadDecl:
+		p.open("&dst.BadDecl{")
+
+		// Bad
+		p.value("Length", n.Length)
+
+		p.decs(n)
+		p.close()
+	case *dst.BadExpr:
+		p.open("&dst.BadExpr{")
+
+		// Bad
+		p.value("Length", n.Length)
+
+		p.decs(n)
+		p.close()
+	case *dst.BadStmt:
+		p.open("&dst.BadStmt{")
+
+		// Bad
+		p.value("Length", n.Length)
+
+		p.decs(n)
+		p.close()
+	case *dst.BasicLit:
+		p.open("&dst.BasicLit{")
+
+		// String: Value
+		p.value("Value", n.Value)
+
+		// Value: Kind
+		p.value("Kind", n.Kind)
+
+		p.decs(n)
+		p.close()
+	case *dst.BinaryExpr:
+		p.open("&dst.BinaryExpr{")
+
+		// Node: X
+		if n.X != nil {
+			p.key("X")
+			p.node(n.X)
+		}
+
+		// Token: Op
+		p.value("Op", n.Op)
+
+		// Node: Y
+		if n.Y != nil {
+			p.key("Y")
+			p.node(n.Y)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.BlockStmt:
+		p.open("&dst.BlockStmt{")
+
+		// List: List
+		if len(n.List) > 0 {
+			p.key("List")
+			p.open("[]dst.Stmt{")
+			for _, v := range n.List {
+				p.elem(false)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		// Token: Rbrace
+		p.value("RbraceHasNoPos", n.RbraceHasNoPos)
+
+		p.decs(n)
+		p.close()
+	case *dst.BranchStmt:
+		p.open("&dst.BranchStmt{")
+
+		// Token: Tok
+		p.value("Tok", n.Tok)
+
+		// Node: Label
+		if n.Label != nil {
+			p.key("Label")
+			p.node(n.Label)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.CallExpr:
+		p.open("&dst.CallExpr{")
+
+		// Node: Fun
+		if n.Fun != nil {
+			p.key("Fun")
+			p.node(n.Fun)
+		}
+
+		// List: Args
+		if len(n.Args) > 0 {
+			p.key("Args")
+			p.open("[]dst.Expr{")
+			for _, v := range n.Args {
+				p.elem(false)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		// Token: Ellipsis
+		p.value("Ellipsis", n.Ellipsis)
+
+		p.decs(n)
+		p.close()
+	case *dst.CaseClause:
+		p.open("&dst.CaseClause{")
+
+		// List: List
+		if len(n.List) > 0 {
+			p.key("List")
+			p.open("[]dst.Expr{")
+			for _, v := range n.List {
+				p.elem(false)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		// List: Body
+		if len(n.Body) > 0 {
+			p.key("Body")
+			p.open("[]dst.Stmt{")
+			for _, v := range n.Body {
+				p.elem(false)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.ChanType:
+		p.open("&dst.ChanType{")
+
+		// Node: Value
+		if n.Value != nil {
+			p.key("Value")
+			p.node(n.Value)
+		}
+
+		// Value: Dir
+		p.value("Dir", n.Dir)
+
+		p.decs(n)
+		p.close()
+	case *dst.CommClause:
+		p.open("&dst.CommClause{")
+
+		// Node: Comm
+		if n.Comm != nil {
+			p.key("Comm")
+			p.node(n.Comm)
+		}
+
+		// List: Body
+		if len(n.Body) > 0 {
+			p.key("Body")
+			p.open("[]dst.Stmt{")
+			for _, v := range n.Body {
+				p.elem(false)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.CommentDecl:
+		p.open("&dst.CommentDecl{")
+
+		p.decs(n)
+		p.close()
+	case *dst.CommentStmt:
+		p.open("&dst.CommentStmt{")
+
+		p.decs(n)
+		p.close()
+	case *dst.CompositeLit:
+		p.open("&dst.CompositeLit{")
+
+		// Node: Type
+		if n.Type != nil {
+			p.key("Type")
+			p.node(n.Type)
+		}
+
+		// List: Elts
+		if len(n.Elts) > 0 {
+			p.key("Elts")
+			p.open("[]dst.Expr{")
+			for _, v := range n.Elts {
+				p.elem(false)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		// Value: Incomplete
+		p.value("Incomplete", n.Incomplete)
+
+		p.decs(n)
+		p.close()
+	case *dst.DeclStmt:
+		p.open("&dst.DeclStmt{")
+
+		// Node: Decl
+		if n.Decl != nil {
+			p.key("Decl")
+			p.node(n.Decl)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.DeferStmt:
+		p.open("&dst.DeferStmt{")
+
+		// Node: Call
+		if n.Call != nil {
+			p.key("Call")
+			p.node(n.Call)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.Ellipsis:
+		p.open("&dst.Ellipsis{")
+
+		// Node: Elt
+		if n.Elt != nil {
+			p.key("Elt")
+			p.node(n.Elt)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.EmptyStmt:
+		p.open("&dst.EmptyStmt{")
+
+		// Value: Implicit
+		p.value("Implicit", n.Implicit)
+
+		p.decs(n)
+		p.close()
+	case *dst.ExprStmt:
+		p.open("&dst.ExprStmt{")
+
+		// Node: X
+		if n.X != nil {
+			p.key("X")
+			p.node(n.X)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.Field:
+		p.open("&dst.Field{")
+
+		// List: Names
+		if len(n.Names) > 0 {
+			p.key("Names")
+			p.open("[]*dst.Ident{")
+			for _, v := range n.Names {
+				p.elem(true)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		// Node: Type
+		if n.Type != nil {
+			p.key("Type")
+			p.node(n.Type)
+		}
+
+		// Node: Tag
+		if n.Tag != nil {
+			p.key("Tag")
+			p.node(n.Tag)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.FieldList:
+		p.open("&dst.FieldList{")
+
+		// Token: Opening
+		p.value("Opening", n.Opening)
+
+		// List: List
+		if len(n.List) > 0 {
+			p.key("List")
+			p.open("[]*dst.Field{")
+			for _, v := range n.List {
+				p.elem(true)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		// Token: Closing
+		p.value("Closing", n.Closing)
+
+		p.decs(n)
+		p.close()
+	case *dst.File:
+		p.open("&dst.File{")
+
+		// Node: Name
+		if n.Name != nil {
+			p.key("Name")
+			p.node(n.Name)
+		}
+
+		// List: Decls
+		if len(n.Decls) > 0 {
+			p.key("Decls")
+			p.open("[]dst.Decl{")
+			for _, v := range n.Decls {
+				p.elem(false)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.ForStmt:
+		p.open("&dst.ForStmt{")
+
+		// Node: Init
+		if n.Init != nil {
+			p.key("Init")
+			p.node(n.Init)
+		}
+
+		// Node: Cond
+		if n.Cond != nil {
+			p.key("Cond")
+			p.node(n.Cond)
+		}
+
+		// Node: Post
+		if n.Post != nil {
+			p.key("Post")
+			p.node(n.Post)
+		}
+
+		// Node: Body
+		if n.Body != nil {
+			p.key("Body")
+			p.node(n.Body)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.FuncDecl:
+		p.open("&dst.FuncDecl{")
+
+		// Node: Recv
+		if n.Recv != nil {
+			p.key("Recv")
+			p.node(n.Recv)
+		}
+
+		// Node: Name
+		if n.Name != nil {
+			p.key("Name")
+			p.node(n.Name)
+		}
+
+		// Init: Type
+		if n.Type != nil {
+			p.key("Type")
+			p.node(n.Type)
+		}
+
+		// Node: Body
+		if n.Body != nil {
+			p.key("Body")
+			p.node(n.Body)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.FuncLit:
+		p.open("&dst.FuncLit{")
+
+		// Node: Type
+		if n.Type != nil {
+			p.key("Type")
+			p.node(n.Type)
+		}
+
+		// Node: Body
+		if n.Body != nil {
+			p.key("Body")
+			p.node(n.Body)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.FuncType:
+		p.open("&dst.FuncType{")
+
+		// Token: Func
+		p.value("Func", n.Func)
+
+		// Node: TypeParams
+		if n.TypeParams != nil {
+			p.key("TypeParams")
+			p.node(n.TypeParams)
+		}
+
+		// Node: Params
+		if n.Params != nil {
+			p.key("Params")
+			p.node(n.Params)
+		}
+
+		// Node: Results
+		if n.Results != nil {
+			p.key("Results")
+			p.node(n.Results)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.GenDecl:
+		p.open("&dst.GenDecl{")
+
+		// Token: Tok
+		p.value("Tok", n.Tok)
+
+		// Token: Lparen
+		p.value("Lparen", n.Lparen)
+
+		// List: Specs
+		if len(n.Specs) > 0 {
+			p.key("Specs")
+			p.open("[]dst.Spec{")
+			for _, v := range n.Specs {
+				p.elem(false)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		// Token: Rparen
+		p.value("Rparen", n.Rparen)
+
+		p.decs(n)
+		p.close()
+	case *dst.GoStmt:
+		p.open("&dst.GoStmt{")
+
+		// Node: Call
+		if n.Call != nil {
+			p.key("Call")
+			p.node(n.Call)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.Ident:
+		p.open("&dst.Ident{")
+
+		// String: Name
+		p.value("Name", n.Name)
+
+		// Path: Path
+		p.value("Path", n.Path)
+
+		p.decs(n)
+		p.close()
+	case *dst.IfStmt:
+		p.open("&dst.IfStmt{")
+
+		// Node: Init
+		if n.Init != nil {
+			p.key("Init")
+			p.node(n.Init)
+		}
+
+		// Node: Cond
+		if n.Cond != nil {
+			p.key("Cond")
+			p.node(n.Cond)
+		}
+
+		// Node: Body
+		if n.Body != nil {
+			p.key("Body")
+			p.node(n.Body)
+		}
+
+		// Node: Else
+		if n.Else != nil {
+			p.key("Else")
+			p.node(n.Else)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.ImportSpec:
+		p.open("&dst.ImportSpec{")
+
+		// Node: Name
+		if n.Name != nil {
+			p.key("Name")
+			p.node(n.Name)
+		}
+
+		// Node: Path
+		if n.Path != nil {
+			p.key("Path")
+			p.node(n.Path)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.IncDecStmt:
+		p.open("&dst.IncDecStmt{")
+
+		// Node: X
+		if n.X != nil {
+			p.key("X")
+			p.node(n.X)
+		}
+
+		// Token: Tok
+		p.value("Tok", n.Tok)
+
+		p.decs(n)
+		p.close()
+	case *dst.IndexExpr:
+		p.open("&dst.IndexExpr{")
+
+		// Node: X
+		if n.X != nil {
+			p.key("X")
+			p.node(n.X)
+		}
+
+		// Node: Index
+		if n.Index != nil {
+			p.key("Index")
+			p.node(n.Index)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.IndexListExpr:
+		p.open("&dst.IndexListExpr{")
+
+		// Node: X
+		if n.X != nil {
+			p.key("X")
+			p.node(n.X)
+		}
+
+		// List: Indices
+		if len(n.Indices) > 0 {
+			p.key("Indices")
+			p.open("[]dst.Expr{")
+			for _, v := range n.Indices {
+				p.elem(false)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.InterfaceType:
+		p.open("&dst.InterfaceType{")
+
+		// Node: Methods
+		if n.Methods != nil {
+			p.key("Methods")
+			p.node(n.Methods)
+		}
+
+		// Value: Incomplete
+		p.value("Incomplete", n.Incomplete)
+
+		p.decs(n)
+		p.close()
+	case *dst.KeyValueExpr:
+		p.open("&dst.KeyValueExpr{")
+
+		// Node: Key
+		if n.Key != nil {
+			p.key("Key")
+			p.node(n.Key)
+		}
+
+		// Node: Value
+		if n.Value != nil {
+			p.key("Value")
+			p.node(n.Value)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.LabeledStmt:
+		p.open("&dst.LabeledStmt{")
+
+		// Node: Label
+		if n.Label != nil {
+			p.key("Label")
+			p.node(n.Label)
+		}
+
+		// Node: Stmt
+		if n.Stmt != nil {
+			p.key("Stmt")
+			p.node(n.Stmt)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.MapType:
+		p.open("&dst.MapType{")
+
+		// Node: Key
+		if n.Key != nil {
+			p.key("Key")
+			p.node(n.Key)
+		}
+
+		// Node: Value
+		if n.Value != nil {
+			p.key("Value")
+			p.node(n.Value)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.Package:
+		p.open("&dst.Package{")
+
+		// Value: Name
+		p.value("Name", n.Name)
+
+		// Map: Files
+		if len(n.Files) > 0 {
+			p.key("Files")
+			p.open("map[string]*dst.File{")
+			for _, k := range sortedKeys(n.Files) {
+				p.mapKey(k)
+				p.node(n.Files[k])
+			}
+			p.close()
+		}
+		p.close()
+	case *dst.ParenExpr:
+		p.open("&dst.ParenExpr{")
+
+		// Node: X
+		if n.X != nil {
+			p.key("X")
+			p.node(n.X)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.RangeStmt:
+		p.open("&dst.RangeStmt{")
+
+		// Node: Key
+		if n.Key != nil {
+			p.key("Key")
+			p.node(n.Key)
+		}
+
+		// Node: Value
+		if n.Value != nil {
+			p.key("Value")
+			p.node(n.Value)
+		}
+
+		// Token: Tok
+		p.value("Tok", n.Tok)
+
+		// Node: X
+		if n.X != nil {
+			p.key("X")
+			p.node(n.X)
+		}
+
+		// Node: Body
+		if n.Body != nil {
+			p.key("Body")
+			p.node(n.Body)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.ReturnStmt:
+		p.open("&dst.ReturnStmt{")
+
+		// List: Results
+		if len(n.Results) > 0 {
+			p.key("Results")
+			p.open("[]dst.Expr{")
+			for _, v := range n.Results {
+				p.elem(false)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.SelectStmt:
+		p.open("&dst.SelectStmt{")
+
+		// Node: Body
+		if n.Body != nil {
+			p.key("Body")
+			p.node(n.Body)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.SelectorExpr:
+		p.open("&dst.SelectorExpr{")
+
+		// Node: X
+		if n.X != nil {
+			p.key("X")
+			p.node(n.X)
+		}
+
+		// Node: Sel
+		if n.Sel != nil {
+			p.key("Sel")
+			p.node(n.Sel)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.SendStmt:
+		p.open("&dst.SendStmt{")
+
+		// Node: Chan
+		if n.Chan != nil {
+			p.key("Chan")
+			p.node(n.Chan)
+		}
+
+		// Node: Value
+		if n.Value != nil {
+			p.key("Value")
+			p.node(n.Value)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.SliceExpr:
+		p.open("&dst.SliceExpr{")
+
+		// Node: X
+		if n.X != nil {
+			p.key("X")
+			p.node(n.X)
+		}
+
+		// Node: Low
+		if n.Low != nil {
+			p.key("Low")
+			p.node(n.Low)
+		}
+
+		// Node: High
+		if n.High != nil {
+			p.key("High")
+			p.node(n.High)
+		}
+
+		// Node: Max
+		if n.Max != nil {
+			p.key("Max")
+			p.node(n.Max)
+		}
+
+		// Value: Slice3
+		p.value("Slice3", n.Slice3)
+
+		p.decs(n)
+		p.close()
+	case *dst.StarExpr:
+		p.open("&dst.StarExpr{")
+
+		// Node: X
+		if n.X != nil {
+			p.key("X")
+			p.node(n.X)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.StructType:
+		p.open("&dst.StructType{")
+
+		// Node: Fields
+		if n.Fields != nil {
+			p.key("Fields")
+			p.node(n.Fields)
+		}
+
+		// Value: Incomplete
+		p.value("Incomplete", n.Incomplete)
+
+		p.decs(n)
+		p.close()
+	case *dst.SwitchStmt:
+		p.open("&dst.SwitchStmt{")
+
+		// Node: Init
+		if n.Init != nil {
+			p.key("Init")
+			p.node(n.Init)
+		}
+
+		// Node: Tag
+		if n.Tag != nil {
+			p.key("Tag")
+			p.node(n.Tag)
+		}
+
+		// Node: Body
+		if n.Body != nil {
+			p.key("Body")
+			p.node(n.Body)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.TypeAssertExpr:
+		p.open("&dst.TypeAssertExpr{")
+
+		// Node: X
+		if n.X != nil {
+			p.key("X")
+			p.node(n.X)
+		}
+
+		// Node: Type
+		if n.Type != nil {
+			p.key("Type")
+			p.node(n.Type)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.TypeSpec:
+		p.open("&dst.TypeSpec{")
+
+		// Node: Name
+		if n.Name != nil {
+			p.key("Name")
+			p.node(n.Name)
+		}
+
+		// Token: Assign
+		p.value("Assign", n.Assign)
+
+		// Node: TypeParams
+		if n.TypeParams != nil {
+			p.key("TypeParams")
+			p.node(n.TypeParams)
+		}
+
+		// Node: Type
+		if n.Type != nil {
+			p.key("Type")
+			p.node(n.Type)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.TypeSwitchStmt:
+		p.open("&dst.TypeSwitchStmt{")
+
+		// Node: Init
+		if n.Init != nil {
+			p.key("Init")
+			p.node(n.Init)
+		}
+
+		// Node: Assign
+		if n.Assign != nil {
+			p.key("Assign")
+			p.node(n.Assign)
+		}
+
+		// Node: Body
+		if n.Body != nil {
+			p.key("Body")
+			p.node(n.Body)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.UnaryExpr:
+		p.open("&dst.UnaryExpr{")
+
+		// Token: Op
+		p.value("Op", n.Op)
+
+		// Node: X
+		if n.X != nil {
+			p.key("X")
+			p.node(n.X)
+		}
+
+		p.decs(n)
+		p.close()
+	case *dst.ValueSpec:
+		p.open("&dst.ValueSpec{")
+
+		// List: Names
+		if len(n.Names) > 0 {
+			p.key("Names")
+			p.open("[]*dst.Ident{")
+			for _, v := range n.Names {
+				p.elem(true)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		// Node: Type
+		if n.Type != nil {
+			p.key("Type")
+			p.node(n.Type)
+		}
+
+		// List: Values
+		if len(n.Values) > 0 {
+			p.key("Values")
+			p.open("[]dst.Expr{")
+			for _, v := range n.Values {
+				p.elem(false)
+				p.node(v)
+			}
+			p.close()
+		}
+
+		p.decs(n)
+		p.close()
+	default:
+		panic(fmt.Sprintf("dstutil: GoString doesn't support node type %T", n))
+	}
+}
@@ -0,0 +1,309 @@
+package dstutil
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dave/dst"
+)
+
+// GoString returns Go source code for a composite literal that constructs n, including
+// decorations and Ident.Path. The output refers to the dst and go/token packages. Objects and
+// Scopes are not included.
+func GoString(n dst.Node) string {
+	p := &goPrinter{}
+	p.node(n)
+	// Formatting the literal as a single expression statement aligns the keys and values. If this
+	// fails for some reason we still return the unformatted code.
+	b, err := format.Source(p.buf.Bytes())
+	if err != nil {
+		return p.buf.String()
+	}
+	return strings.TrimSpace(string(b))
+}
+
+type goPrinter struct {
+	buf   bytes.Buffer
+	stack []bool // for each open composite literal, has anything been added yet?
+	elide bool   // omit the type of the next composite literal
+}
+
+func (p *goPrinter) open(s string) {
+	if p.elide {
+		// "&dst.Ident{" -> "{"
+		s = s[strings.Index(s, "{"):]
+		p.elide = false
+	}
+	p.buf.WriteString(s)
+	p.stack = append(p.stack, false)
+}
+
+func (p *goPrinter) close() {
+	if p.stack[len(p.stack)-1] {
+		p.buf.WriteString(",\n}")
+	} else {
+		p.buf.WriteString("}")
+	}
+	p.stack = p.stack[:len(p.stack)-1]
+}
+
+func (p *goPrinter) next() {
+	if p.stack[len(p.stack)-1] {
+		p.buf.WriteString(",")
+	}
+	p.stack[len(p.stack)-1] = true
+	p.buf.WriteString("\n")
+}
+
+func (p *goPrinter) key(name string) {
+	p.next()
+	p.buf.WriteString(name)
+	p.buf.WriteString(": ")
+}
+
+// nilNode writes nil and returns true if n is nil or a nil pointer (e.g. a nil element in a list).
+func (p *goPrinter) nilNode(n dst.Node) bool {
+	if n != nil && !reflect.ValueOf(n).IsNil() {
+		return false
+	}
+	p.elide = false
+	p.buf.WriteString("nil")
+	return true
+}
+
+// elem starts a new slice element. If elide is true, the type of the element is omitted (only
+// valid for slices of pointers to structs).
+func (p *goPrinter) elem(elide bool) {
+	p.next()
+	p.elide = elide
+}
+
+func (p *goPrinter) mapKey(key string) {
+	p.next()
+	p.buf.WriteString(strconv.Quote(key))
+	p.buf.WriteString(": ")
+}
+
+// value adds a field with a basic value. Zero values are omitted.
+func (p *goPrinter) value(name string, v interface{}) {
+	var s string
+	switch v := v.(type) {
+	case bool:
+		if !v {
+			return
+		}
+		s = "true"
+	case string:
+		if v == "" {
+			return
+		}
+		s = quote(v)
+	case int:
+		if v == 0 {
+			return
+		}
+		s = strconv.Itoa(v)
+	case token.Token:
+		if v == token.ILLEGAL {
+			return
+		}
+		s = tokenString(v)
+	case dst.ChanDir:
+		switch v {
+		case 0:
+			return
+		case dst.SEND:
+			s = "dst.SEND"
+		case dst.RECV:
+			s = "dst.RECV"
+		case dst.SEND | dst.RECV:
+			s = "dst.SEND | dst.RECV"
+		default:
+			s = fmt.Sprintf("dst.ChanDir(%d)", v)
+		}
+	default:
+		panic(fmt.Sprintf("dstutil: GoString doesn't support value type %T", v))
+	}
+	p.key(name)
+	p.buf.WriteString(s)
+}
+
+// decs adds the Decs field if the node has any decorations or line spacing.
+func (p *goPrinter) decs(n dst.Node) {
+	before, after, points := decorations(n)
+	var empty = before == dst.None && after == dst.None
+	for _, point := range points {
+		if len(point.Decs) > 0 {
+			empty = false
+		}
+	}
+	if empty {
+		return
+	}
+	p.key("Decs")
+	p.open(fmt.Sprintf("dst.%sDecorations{", strings.TrimPrefix(fmt.Sprintf("%T", n), "*dst.")))
+	var start, end dst.Decorations
+	for _, point := range points {
+		switch point.Name {
+		case "Start":
+			start = point.Decs
+		case "End":
+			end = point.Decs
+		}
+	}
+	if before != dst.None || after != dst.None || len(start) > 0 || len(end) > 0 {
+		p.key("NodeDecs")
+		p.open("dst.NodeDecs{")
+		p.space("Before", before)
+		p.decorations("Start", start)
+		p.decorations("End", end)
+		p.space("After", after)
+		p.close()
+	}
+	for _, point := range points {
+		if point.Name == "Start" || point.Name == "End" {
+			continue
+		}
+		p.decorations(point.Name, point.Decs)
+	}
+	p.close()
+}
+
+func (p *goPrinter) space(name string, s dst.SpaceType) {
+	if s == dst.None {
+		return
+	}
+	p.key(name)
+	p.buf.WriteString("dst." + s.String())
+}
+
+func (p *goPrinter) decorations(name string, decs dst.Decorations) {
+	if len(decs) == 0 {
+		return
+	}
+	p.key(name)
+	p.buf.WriteString("dst.Decorations{")
+	for i, d := range decs {
+		if i > 0 {
+			p.buf.WriteString(", ")
+		}
+		p.buf.WriteString(quote(d))
+	}
+	p.buf.WriteString("}")
+}
+
+// quote returns a Go string literal for s, using a raw string if s contains double quotes (e.g.
+// the Value of a string BasicLit).
+func quote(s string) string {
+	if strings.Contains(s, `"`) && strconv.CanBackquote(s) {
+		return "`" + s + "`"
+	}
+	return strconv.Quote(s)
+}
+
+func sortedKeys(m map[string]*dst.File) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func tokenString(t token.Token) string {
+	if name, ok := tokenNames[t]; ok {
+		return "token." + name
+	}
+	return fmt.Sprintf("token.Token(%d)", t)
+}
+
+var tokenNames = map[token.Token]string{
+	token.ILLEGAL:        "ILLEGAL",
+	token.EOF:            "EOF",
+	token.COMMENT:        "COMMENT",
+	token.IDENT:          "IDENT",
+	token.INT:            "INT",
+	token.FLOAT:          "FLOAT",
+	token.IMAG:           "IMAG",
+	token.CHAR:           "CHAR",
+	token.STRING:         "STRING",
+	token.ADD:            "ADD",
+	token.SUB:            "SUB",
+	token.MUL:            "MUL",
+	token.QUO:            "QUO",
+	token.REM:            "REM",
+	token.AND:            "AND",
+	token.OR:             "OR",
+	token.XOR:            "XOR",
+	token.SHL:            "SHL",
+	token.SHR:            "SHR",
+	token.AND_NOT:        "AND_NOT",
+	token.ADD_ASSIGN:     "ADD_ASSIGN",
+	token.SUB_ASSIGN:     "SUB_ASSIGN",
+	token.MUL_ASSIGN:     "MUL_ASSIGN",
+	token.QUO_ASSIGN:     "QUO_ASSIGN",
+	token.REM_ASSIGN:     "REM_ASSIGN",
+	token.AND_ASSIGN:     "AND_ASSIGN",
+	token.OR_ASSIGN:      "OR_ASSIGN",
+	token.XOR_ASSIGN:     "XOR_ASSIGN",
+	token.SHL_ASSIGN:     "SHL_ASSIGN",
+	token.SHR_ASSIGN:     "SHR_ASSIGN",
+	token.AND_NOT_ASSIGN: "AND_NOT_ASSIGN",
+	token.LAND:           "LAND",
+	token.LOR:            "LOR",
+	token.ARROW:          "ARROW",
+	token.INC:            "INC",
+	token.DEC:            "DEC",
+	token.EQL:            "EQL",
+	token.LSS:            "LSS",
+	token.GTR:            "GTR",
+	token.ASSIGN:         "ASSIGN",
+	token.NOT:            "NOT",
+	token.NEQ:            "NEQ",
+	token.LEQ:            "LEQ",
+	token.GEQ:            "GEQ",
+	token.DEFINE:         "DEFINE",
+	token.ELLIPSIS:       "ELLIPSIS",
+	token.LPAREN:         "LPAREN",
+	token.LBRACK:         "LBRACK",
+	token.LBRACE:         "LBRACE",
+	token.COMMA:          "COMMA",
+	token.PERIOD:         "PERIOD",
+	token.RPAREN:         "RPAREN",
+	token.RBRACK:         "RBRACK",
+	token.RBRACE:         "RBRACE",
+	token.SEMICOLON:      "SEMICOLON",
+	token.COLON:          "COLON",
+	token.BREAK:          "BREAK",
+	token.CASE:           "CASE",
+	token.CHAN:           "CHAN",
+	token.CONST:          "CONST",
+	token.CONTINUE:       "CONTINUE",
+	token.DEFAULT:        "DEFAULT",
+	token.DEFER:          "DEFER",
+	token.ELSE:           "ELSE",
+	token.FALLTHROUGH:    "FALLTHROUGH",
+	token.FOR:            "FOR",
+	token.FUNC:           "FUNC",
+	token.GO:             "GO",
+	token.GOTO:           "GOTO",
+	token.IF:             "IF",
+	token.IMPORT:         "IMPORT",
+	token.INTERFACE:      "INTERFACE",
+	token.MAP:            "MAP",
+	token.PACKAGE:        "PACKAGE",
+	token.RANGE:          "RANGE",
+	token.RETURN:         "RETURN",
+	token.SELECT:         "SELECT",
+	token.STRUCT:         "STRUCT",
+	token.SWITCH:         "SWITCH",
+	token.TYPE:           "TYPE",
+	token.VAR:            "VAR",
+	token.TILDE:          "TILDE",
+}
@@ -0,0 +1,207 @@
+package dstutil_test
+
+import (
+	"bytes"
+	"go/token"
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+	"github.com/dave/dst/decorator/resolver/goast"
+	"github.com/dave/dst/decorator/resolver/guess"
+	"github.com/dave/dst/dstutil"
+)
+
+func TestGoString(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   string
+		expect string
+	}{
+		{
+			name: "decorations",
+			code: "package a\n\n// foo\nvar a = b(c, \"d\") // e\n",
+			expect: "&dst.GenDecl{\n" +
+				"\tTok: token.VAR,\n" +
+				"\tSpecs: []dst.Spec{\n" +
+				"\t\t&dst.ValueSpec{\n" +
+				"\t\t\tNames: []*dst.Ident{\n" +
+				"\t\t\t\t{\n" +
+				"\t\t\t\t\tName: \"a\",\n" +
+				"\t\t\t\t},\n" +
+				"\t\t\t},\n" +
+				"\t\t\tValues: []dst.Expr{\n" +
+				"\t\t\t\t&dst.CallExpr{\n" +
+				"\t\t\t\t\tFun: &dst.Ident{\n" +
+				"\t\t\t\t\t\tName: \"b\",\n" +
+				"\t\t\t\t\t},\n" +
+				"\t\t\t\t\tArgs: []dst.Expr{\n" +
+				"\t\t\t\t\t\t&dst.Ident{\n" +
+				"\t\t\t\t\t\t\tName: \"c\",\n" +
+				"\t\t\t\t\t\t},\n" +
+				"\t\t\t\t\t\t&dst.BasicLit{\n" +
+				"\t\t\t\t\t\t\tValue: `\"d\"`,\n" +
+				"\t\t\t\t\t\t\tKind:  token.STRING,\n" +
+				"\t\t\t\t\t\t},\n" +
+				"\t\t\t\t\t},\n" +
+				"\t\t\t\t},\n" +
+				"\t\t\t},\n" +
+				"\t\t},\n" +
+				"\t},\n" +
+				"\tDecs: dst.GenDeclDecorations{\n" +
+				"\t\tNodeDecs: dst.NodeDecs{\n" +
+				"\t\t\tBefore: dst.EmptyLine,\n" +
+				"\t\t\tStart:  dst.Decorations{\"// foo\"},\n" +
+				"\t\t\tEnd:    dst.Decorations{\"// e\"},\n" +
+				"\t\t},\n" +
+				"\t},\n" +
+				"}",
+		},
+		{
+			name: "chan",
+			code: "package a\n\nvar a chan<- *int\n",
+			expect: "&dst.GenDecl{\n" +
+				"\tTok: token.VAR,\n" +
+				"\tSpecs: []dst.Spec{\n" +
+				"\t\t&dst.ValueSpec{\n" +
+				"\t\t\tNames: []*dst.Ident{\n" +
+				"\t\t\t\t{\n" +
+				"\t\t\t\t\tName: \"a\",\n" +
+				"\t\t\t\t},\n" +
+				"\t\t\t},\n" +
+				"\t\t\tType: &dst.ChanType{\n" +
+				"\t\t\t\tValue: &dst.StarExpr{\n" +
+				"\t\t\t\t\tX: &dst.Ident{\n" +
+				"\t\t\t\t\t\tName: \"int\",\n" +
+				"\t\t\t\t\t},\n" +
+				"\t\t\t\t},\n" +
+				"\t\t\t\tDir: dst.SEND,\n" +
+				"\t\t\t},\n" +
+				"\t\t},\n" +
+				"\t},\n" +
+				"\tDecs: dst.GenDeclDecorations{\n" +
+				"\t\tNodeDecs: dst.NodeDecs{\n" +
+				"\t\t\tBefore: dst.EmptyLine,\n" +
+				"\t\t},\n" +
+				"\t},\n" +
+				"}",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := decorator.Parse(test.code)
+			if err != nil {
+				t.Fatal(err)
+			}
+			found := dstutil.GoString(f.Decls[0])
+			if found != test.expect {
+				t.Errorf("expected:\n%s\nfound:\n%s", test.expect, found)
+			}
+		})
+	}
+}
+
+func TestGoStringCompiles(t *testing.T) {
+	// This is the output of GoString for the function below, with import management enabled:
+	//
+	// // foo
+	// func main() {
+	// 	fmt.Println("hi") // bar
+	// }
+	decl := &dst.FuncDecl{
+		Name: &dst.Ident{
+			Name: "main",
+		},
+		Type: &dst.FuncType{
+			Func: true,
+			Params: &dst.FieldList{
+				Opening: true,
+				Closing: true,
+			},
+		},
+		Body: &dst.BlockStmt{
+			List: []dst.Stmt{
+				&dst.ExprStmt{
+					X: &dst.CallExpr{
+						Fun: &dst.Ident{
+							Name: "Println",
+							Path: "fmt",
+						},
+						Args: []dst.Expr{
+							&dst.BasicLit{
+								Value: `"hi"`,
+								Kind:  token.STRING,
+							},
+						},
+					},
+					Decs: dst.ExprStmtDecorations{
+						NodeDecs: dst.NodeDecs{
+							Before: dst.NewLine,
+							End:    dst.Decorations{"// bar"},
+							After:  dst.NewLine,
+						},
+					},
+				},
+			},
+		},
+		Decs: dst.FuncDeclDecorations{
+			NodeDecs: dst.NodeDecs{
+				Before: dst.EmptyLine,
+				Start:  dst.Decorations{"// foo"},
+			},
+		},
+	}
+
+	code := "package main\n\nimport \"fmt\"\n\n// foo\nfunc main() {\n\tfmt.Println(\"hi\") // bar\n}\n"
+	d := decorator.NewDecoratorWithImports(token.NewFileSet(), "main", goast.New())
+	f, err := d.Parse(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found, expect := dstutil.GoString(f.Decls[1]), dstutil.GoString(decl); found != expect {
+		t.Errorf("expected:\n%s\nfound:\n%s", expect, found)
+	}
+
+	f.Decls[1] = decl
+	buf := &bytes.Buffer{}
+	if err := decorator.NewRestorerWithImports("main", guess.New()).Fprint(buf, f); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != code {
+		t.Errorf("expected:\n%s\nfound:\n%s", code, buf.String())
+	}
+}
+
+func TestGoStringNil(t *testing.T) {
+	if found := dstutil.GoString(nil); found != "nil" {
+		t.Errorf("expected nil, found %s", found)
+	}
+	var id *dst.Ident
+	if found := dstutil.GoString(id); found != "nil" {
+		t.Errorf("expected nil, found %s", found)
+	}
+	n := &dst.CallExpr{Fun: dst.NewIdent("a"), Args: []dst.Expr{nil}}
+	expect := "&dst.CallExpr{\n" +
+		"\tFun: &dst.Ident{\n" +
+		"\t\tName: \"a\",\n" +
+		"\t},\n" +
+		"\tArgs: []dst.Expr{\n" +
+		"\t\tnil,\n" +
+		"\t},\n" +
+		"}"
+	if found := dstutil.GoString(n); found != expect {
+		t.Errorf("expected:\n%s\nfound:\n%s", expect, found)
+	}
+	f := &dst.Field{Names: []*dst.Ident{nil, dst.NewIdent("b")}}
+	expect = "&dst.Field{\n" +
+		"\tNames: []*dst.Ident{\n" +
+		"\t\tnil,\n" +
+		"\t\t{\n" +
+		"\t\t\tName: \"b\",\n" +
+		"\t\t},\n" +
+		"\t},\n" +
+		"}"
+	if found := dstutil.GoString(f); found != expect {
+		t.Errorf("expected:\n%s\nfound:\n%s", expect, found)
+	}
+}
@@ -0,0 +1,188 @@
+// Package layout wraps long lines by putting the arguments of calls, the elements of composite
+// literals and the fields of field lists (parameters, results, struct fields etc.) on separate
+// lines. Widths are measured in the restored source, so the result matches the printed output:
+//
+//	c := &layout.Config{Width: 100}
+//	wrapped, err := c.Wrap(f)
+//	if err != nil {
+//		...
+//	}
+//	...
+//	for _, n := range wrapped {
+//		layout.Unwrap(n)
+//	}
+package layout
+
+import (
+	"bytes"
+	"go/token"
+	"strings"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+)
+
+// Config configures Wrap.
+type Config struct {
+	Width    int                 // The maximum width of a line (required)
+	TabWidth int                 // The width of a tab when measuring lines (default 8, the same as gofmt)
+	Restorer *decorator.Restorer // The restorer used to print the file (default decorator.NewRestorer())
+}
+
+// Wrap puts the items of lists on separate lines until no line is longer than Width, or until
+// there are no more lists that can be wrapped. For each line that is too long, the first list
+// that starts on the line and extends past Width is wrapped by setting NewLine before each item
+// and after the last item. The lists are the Args of CallExpr, the Elts of CompositeLit and the
+// List of FieldList. Lists that already have items on separate lines are left unchanged, as are
+// field lists without brackets (e.g. a single unnamed result). Wrap returns the CallExpr,
+// CompositeLit and FieldList nodes that were wrapped, in the order they were wrapped.
+func (c *Config) Wrap(f *dst.File) ([]dst.Node, error) {
+	var wrapped []dst.Node
+	for {
+		lines, err := c.lines(f)
+		if err != nil {
+			return nil, err
+		}
+		var changed bool
+		done := map[int]bool{}
+		dst.Inspect(f, func(n dst.Node) bool {
+			if n == nil || !canWrap(n) {
+				return true
+			}
+			l, ok := lines[n]
+			if !ok || done[l.line] || !l.long {
+				return true
+			}
+			wrap(n)
+			wrapped = append(wrapped, n)
+			done[l.line] = true
+			changed = true
+			return true
+		})
+		if !changed {
+			return wrapped, nil
+		}
+	}
+}
+
+// Unwrap puts the items of a CallExpr, CompositeLit or FieldList on the same line as the opening
+// bracket by removing the spacing before each item and after the last item. Comments in the
+// decorations of the items are unchanged, so a line comment still ends the line. Unwrap does
+// nothing for other nodes.
+func Unwrap(n dst.Node) {
+	for _, item := range items(n) {
+		item.Decorations().Before = dst.None
+		item.Decorations().After = dst.None
+	}
+}
+
+// lineInfo is the position of a list in the printed output.
+type lineInfo struct {
+	line int  // the line that the list starts on
+	long bool // the line is longer than Width, and the list extends past Width
+}
+
+// lines prints f and returns the position of each list that can be wrapped. The positions are
+// found with FileRestorer.Range, so the end of each list in the printed output is known.
+func (c *Config) lines(f *dst.File) (map[dst.Node]lineInfo, error) {
+	r := c.Restorer
+	if r == nil {
+		r = decorator.NewRestorer()
+	}
+	fr := r.FileRestorer()
+	buf := &bytes.Buffer{}
+	if err := fr.Fprint(buf, f); err != nil {
+		return nil, err
+	}
+
+	src := strings.Split(buf.String(), "\n")
+	lines := map[dst.Node]lineInfo{}
+	var err error
+	dst.Inspect(f, func(n dst.Node) bool {
+		if err != nil {
+			return false
+		}
+		if n == nil || !canWrap(n) {
+			return true
+		}
+		var start, end token.Position
+		start, end, err = fr.Range(n)
+		if err != nil || !start.IsValid() {
+			return true
+		}
+		text := src[start.Line-1]
+		l := lineInfo{line: start.Line}
+		if c.width(text) > c.Width {
+			l.long = end.Line > start.Line || c.width(text[:end.Column-1]) > c.Width
+		}
+		lines[n] = l
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// width returns the width of s with tabs expanded.
+func (c *Config) width(s string) int {
+	tab := c.TabWidth
+	if tab == 0 {
+		tab = 8
+	}
+	var w int
+	for _, r := range s {
+		if r == '\t' {
+			w += tab - w%tab
+			continue
+		}
+		w++
+	}
+	return w
+}
+
+// items returns the items of a list that can be wrapped.
+func items(n dst.Node) []dst.Node {
+	var out []dst.Node
+	switch n := n.(type) {
+	case *dst.CallExpr:
+		for _, v := range n.Args {
+			out = append(out, v)
+		}
+	case *dst.CompositeLit:
+		for _, v := range n.Elts {
+			out = append(out, v)
+		}
+	case *dst.FieldList:
+		if !n.Opening {
+			return nil
+		}
+		for _, v := range n.List {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// canWrap returns true if n is a list with items that are all on the same line as the opening
+// bracket.
+func canWrap(n dst.Node) bool {
+	list := items(n)
+	if len(list) == 0 {
+		return false
+	}
+	for _, item := range list {
+		if item.Decorations().Before != dst.None {
+			return false
+		}
+	}
+	return list[len(list)-1].Decorations().After == dst.None
+}
+
+func wrap(n dst.Node) {
+	list := items(n)
+	for _, item := range list {
+		item.Decorations().Before = dst.NewLine
+	}
+	list[len(list)-1].Decorations().After = dst.NewLine
+}
@@ -0,0 +1,136 @@
+package layout_test
+
+import (
+	"bytes"
+	"go/format"
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+	"github.com/dave/dst/dstutil/layout"
+)
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name     string
+		width    int
+		tabWidth int
+		code     string
+		expect   string
+	}{
+		{
+			name:   "short",
+			width:  40,
+			code:   "package a\n\nvar a = b(c, d)\n",
+			expect: "package a\n\nvar a = b(c, d)\n",
+		},
+		{
+			name:   "call",
+			width:  30,
+			code:   "package a\n\nvar a = b(cccccccccc, dddddddddd, eeeeeeeeee)\n",
+			expect: "package a\n\nvar a = b(\n\tcccccccccc,\n\tdddddddddd,\n\teeeeeeeeee,\n)\n",
+		},
+		{
+			name:   "nested",
+			width:  30,
+			code:   "package a\n\nvar a = b(c, d(eeeeeeeeee, ffffffffff, gggggggggg))\n",
+			expect: "package a\n\nvar a = b(\n\tc,\n\td(\n\t\teeeeeeeeee,\n\t\tffffffffff,\n\t\tgggggggggg,\n\t),\n)\n",
+		},
+		{
+			name:   "skip-short-list",
+			width:  30,
+			code:   "package a\n\nvar a = b(c) + d(eeeeeeeeee, ffffffffff)\n",
+			expect: "package a\n\nvar a = b(c) + d(\n\teeeeeeeeee,\n\tffffffffff,\n)\n",
+		},
+		{
+			name:   "composite",
+			width:  30,
+			code:   "package a\n\nvar a = []string{\"bbbbbbbbbb\", \"cccccccccc\"}\n",
+			expect: "package a\n\nvar a = []string{\n\t\"bbbbbbbbbb\",\n\t\"cccccccccc\",\n}\n",
+		},
+		{
+			name:   "params",
+			width:  30,
+			code:   "package a\n\nfunc a(bbbbbbbbbb int, cccccccccc string) {\n\treturn\n}\n",
+			expect: "package a\n\nfunc a(\n\tbbbbbbbbbb int,\n\tcccccccccc string,\n) {\n\treturn\n}\n",
+		},
+		{
+			name:   "tabs",
+			width:  30,
+			code:   "package a\n\nfunc a() {\n\t\t\tb(cccccc, dddddd)\n}\n",
+			expect: "package a\n\nfunc a() {\n\tb(cccccc, dddddd)\n}\n",
+		},
+		{
+			name:     "tab-width-1",
+			width:    20,
+			tabWidth: 1,
+			code:     "package a\n\nfunc a() {\n\tif b {\n\t\tc(dddddd, eeeeee)\n\t}\n}\n",
+			expect:   "package a\n\nfunc a() {\n\tif b {\n\t\tc(dddddd, eeeeee)\n\t}\n}\n",
+		},
+		{
+			name:   "tab-width-8",
+			width:  20,
+			code:   "package a\n\nfunc a() {\n\tif b {\n\t\tc(dddddd, eeeeee)\n\t}\n}\n",
+			expect: "package a\n\nfunc a() {\n\tif b {\n\t\tc(\n\t\t\tdddddd,\n\t\t\teeeeee,\n\t\t)\n\t}\n}\n",
+		},
+		{
+			name:   "already-wrapped",
+			width:  10,
+			code:   "package a\n\nvar aaaaaaaaaa = b(\n\tc, d,\n)\n",
+			expect: "package a\n\nvar aaaaaaaaaa = b(\n\tc, d,\n)\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := decorator.Parse(test.code)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := (&layout.Config{Width: test.width, TabWidth: test.tabWidth}).Wrap(f); err != nil {
+				t.Fatal(err)
+			}
+			buf := &bytes.Buffer{}
+			if err := decorator.Fprint(buf, f); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != test.expect {
+				t.Errorf("expected:\n%s\nfound:\n%s", test.expect, buf.String())
+			}
+			formatted, err := format.Source(buf.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(formatted) != buf.String() {
+				t.Errorf("output isn't gofmt-stable, gofmt gives:\n%s", formatted)
+			}
+		})
+	}
+}
+
+func TestUnwrap(t *testing.T) {
+	code := "package a\n\nvar a = b(c, dddddddddd, eeeeeeeeee)\n"
+	f, err := decorator.Parse(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrapped, err := (&layout.Config{Width: 20}).Wrap(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wrapped) != 1 {
+		t.Fatalf("expected 1 wrapped node, found %d", len(wrapped))
+	}
+	if _, ok := wrapped[0].(*dst.CallExpr); !ok {
+		t.Fatalf("expected *dst.CallExpr, found %T", wrapped[0])
+	}
+	for _, n := range wrapped {
+		layout.Unwrap(n)
+	}
+	buf := &bytes.Buffer{}
+	if err := decorator.Fprint(buf, f); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != code {
+		t.Fatalf("expected:\n%s\nfound:\n%s", code, buf.String())
+	}
+}
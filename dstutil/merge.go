@@ -0,0 +1,461 @@
+package dstutil
+
+import (
+	"go/token"
+	"reflect"
+
+	"github.com/dave/dst"
+)
+
+// Conflict is a change made by both ours and theirs that Merge can't reconcile. The nodes are from
+// the input files. Conflicting list changes (e.g. statements inserted at the same position) may
+// have any number of nodes in each of Base, Ours and Theirs.
+type Conflict struct {
+	Path   Path       // The path of the conflicting node (or the first node in a list) in the merged file
+	Base   []dst.Node // The nodes in base
+	Ours   []dst.Node // The nodes in ours
+	Theirs []dst.Node // The nodes in theirs
+}
+
+// Merge performs a three-way merge of ours and theirs, which are both modified versions of base.
+// Declarations (File.Decls), specs (GenDecl.Specs) and statements (e.g. BlockStmt.List) are
+// matched by structure and position, and changes to different declarations or statements are
+// combined. Nested blocks are merged recursively, and decorations are merged for each decoration
+// point. Declarations and specs inserted at the same position in both are combined. When ours and
+// theirs change the same node in different ways, a Conflict is returned and the merged file
+// contains the version from ours. The input files are not modified.
+func Merge(base, ours, theirs *dst.File) (*dst.File, []Conflict) {
+	m := &merger{}
+	f := m.node(nil, base, ours, theirs).(*dst.File)
+	f.Imports = nil
+	f.Unresolved = nil
+	for _, decl := range f.Decls {
+		if gd, ok := decl.(*dst.GenDecl); ok && gd.Tok == token.IMPORT {
+			for _, spec := range gd.Specs {
+				f.Imports = append(f.Imports, spec.(*dst.ImportSpec))
+			}
+		}
+	}
+	return f, m.conflicts
+}
+
+type merger struct {
+	conflicts []Conflict
+}
+
+func (m *merger) conflict(path Path, base, ours, theirs []dst.Node) {
+	m.conflicts = append(m.conflicts, Conflict{
+		Path:   append(Path{}, path...),
+		Base:   base,
+		Ours:   ours,
+		Theirs: theirs,
+	})
+}
+
+var (
+	mergeStmtType  = reflect.TypeOf((*dst.Stmt)(nil)).Elem()
+	mergeBlockType = reflect.TypeOf((*dst.BlockStmt)(nil))
+	mergeStmtsType = reflect.TypeOf([]dst.Stmt(nil))
+	mergeDeclsType = reflect.TypeOf([]dst.Decl(nil))
+	mergeSpecsType = reflect.TypeOf([]dst.Spec(nil))
+)
+
+// mergeIsContainer reports whether a field is merged recursively instead of as part of the node.
+func mergeIsContainer(t reflect.Type) bool {
+	switch t {
+	case mergeStmtType, mergeBlockType, mergeStmtsType, mergeDeclsType, mergeSpecsType:
+		return true
+	}
+	return false
+}
+
+// node merges three versions of a node. Nodes are merged recursively if they have the same type
+// and only one of ours and theirs changes the fields of the node that are not containers.
+func (m *merger) node(path Path, b, o, t dst.Node) dst.Node {
+	switch {
+	case mergeEqual(o, t):
+		return mergeClone(o)
+	case mergeEqual(b, o):
+		return mergeClone(t)
+	case mergeEqual(b, t):
+		return mergeClone(o)
+	}
+	if mergeIsNil(b) || mergeIsNil(o) || mergeIsNil(t) || reflect.TypeOf(b) != reflect.TypeOf(o) || reflect.TypeOf(o) != reflect.TypeOf(t) {
+		m.conflict(path, []dst.Node{b}, []dst.Node{o}, []dst.Node{t})
+		return mergeClone(o)
+	}
+
+	bShell, oShell, tShell := mergeShell(b), mergeShell(o), mergeShell(t)
+	var out dst.Node
+	switch {
+	case mergeEqual(oShell, tShell), mergeEqual(bShell, tShell):
+		out = oShell
+	case mergeEqual(bShell, oShell):
+		out = tShell
+	default:
+		m.conflict(path, []dst.Node{b}, []dst.Node{o}, []dst.Node{t})
+		return mergeClone(o)
+	}
+
+	m.decorations(path, out, b, o, t)
+
+	bv, ov, tv, outv := mergeFields(b), mergeFields(o), mergeFields(t), mergeFields(out)
+	for i := 0; i < bv.NumField(); i++ {
+		ft := bv.Type().Field(i)
+		if !mergeIsContainer(ft.Type) {
+			continue
+		}
+		if ft.Type.Kind() == reflect.Slice {
+			unordered := ft.Type == mergeDeclsType || ft.Type == mergeSpecsType
+			merged := m.list(path, ft.Name, mergeGetNodes(bv.Field(i)), mergeGetNodes(ov.Field(i)), mergeGetNodes(tv.Field(i)), unordered)
+			mergeSetNodes(outv.Field(i), merged)
+			continue
+		}
+		step := PathStep{Field: ft.Name, Index: -1}
+		merged := m.node(append(path, step), mergeGetNode(bv.Field(i)), mergeGetNode(ov.Field(i)), mergeGetNode(tv.Field(i)))
+		mergeSetNode(outv.Field(i), merged)
+	}
+	return out
+}
+
+// decorations merges the decorations of b, o and t into out. Each decoration point (and the
+// Before and After spacing) is merged separately.
+func (m *merger) decorations(path Path, out, b, o, t dst.Node) {
+	outd := mergeFields(out).FieldByName("Decs")
+	if !outd.IsValid() {
+		return
+	}
+	var conflict bool
+	var merge func(out, b, o, t reflect.Value)
+	merge = func(out, b, o, t reflect.Value) {
+		for i := 0; i < out.NumField(); i++ {
+			if out.Field(i).Kind() == reflect.Struct {
+				merge(out.Field(i), b.Field(i), o.Field(i), t.Field(i))
+				continue
+			}
+			switch {
+			case mergeEqualValue(o.Field(i), t.Field(i)), mergeEqualValue(b.Field(i), t.Field(i)):
+				out.Field(i).Set(o.Field(i))
+			case mergeEqualValue(b.Field(i), o.Field(i)):
+				out.Field(i).Set(t.Field(i))
+			default:
+				out.Field(i).Set(o.Field(i))
+				conflict = true
+			}
+		}
+	}
+	merge(outd, mergeFields(b).FieldByName("Decs"), mergeFields(o).FieldByName("Decs"), mergeFields(t).FieldByName("Decs"))
+	if conflict {
+		m.conflict(path, []dst.Node{b}, []dst.Node{o}, []dst.Node{t})
+	}
+}
+
+// list merges three versions of a list of nodes. Nodes from base that are matched in both ours and
+// theirs are anchors that are merged with node. The chunks between the anchors are merged as a
+// whole.
+func (m *merger) list(path Path, field string, b, o, t []dst.Node, unordered bool) []dst.Node {
+	mo, mt := mergeMatchList(b, o), mergeMatchList(b, t)
+	var out []dst.Node
+	var i, j, k int
+	for {
+		next := i
+		for next < len(b) && (mo[next] < 0 || mt[next] < 0) {
+			next++
+		}
+		oj, tk := len(o), len(t)
+		if next < len(b) {
+			oj, tk = mo[next], mt[next]
+		}
+		out = append(out, m.chunk(append(path, PathStep{Field: field, Index: len(out)}), b[i:next], o[j:oj], t[k:tk], unordered)...)
+		if next == len(b) {
+			break
+		}
+		out = append(out, m.node(append(path, PathStep{Field: field, Index: len(out)}), b[next], o[oj], t[tk]))
+		i, j, k = next+1, oj+1, tk+1
+	}
+	return out
+}
+
+// chunk merges three versions of a section of a list between anchors.
+func (m *merger) chunk(path Path, b, o, t []dst.Node, unordered bool) []dst.Node {
+	switch {
+	case mergeEqualList(o, t):
+		return mergeCloneList(o)
+	case mergeEqualList(b, o):
+		return mergeCloneList(t)
+	case mergeEqualList(b, t):
+		return mergeCloneList(o)
+	case len(b) == 0 && unordered:
+		out := mergeCloneList(o)
+	Theirs:
+		for _, tn := range t {
+			for _, on := range o {
+				if mergeEqual(on, tn) {
+					continue Theirs
+				}
+			}
+			out = append(out, mergeClone(tn))
+		}
+		return out
+	}
+	m.conflict(path, b, o, t)
+	return mergeCloneList(o)
+}
+
+// mergeMatchList matches the nodes in a modified list to the nodes in base, preserving their order.
+// It returns the index in modified of each node in base, or -1 for deleted nodes. First the longest
+// sequence of identical nodes (ignoring decorations) is matched, then the remaining nodes between
+// them are matched by similarity.
+func mergeMatchList(base, modified []dst.Node) []int {
+	out := make([]int, len(base))
+	for i := range out {
+		out[i] = -1
+	}
+	identical := func(i, j int) float64 {
+		if dst.Equal(base[i], modified[j], dst.IgnoreDecorations(), dst.IgnoreSpacing()) {
+			return 1
+		}
+		return 0
+	}
+	mergeAlign(0, len(base), 0, len(modified), identical, out)
+
+	// match the nodes in the gaps between the identical nodes
+	i, j := 0, 0
+	for i <= len(base) {
+		next := i
+		for next < len(base) && out[next] < 0 {
+			next++
+		}
+		end := len(modified)
+		if next < len(base) {
+			end = out[next]
+		}
+		similar := func(i, j int) float64 {
+			if s := mergeSimilarity(base[i], modified[j]); s >= minDice {
+				return s
+			}
+			return 0
+		}
+		mergeAlign(i, next, j, end, similar, out)
+		i, j = next+1, end+1
+	}
+	return out
+}
+
+// mergeAlign finds the order preserving matching between base[bi:bj] and modified[mi:mj] with the
+// maximum total score, and records it in out.
+func mergeAlign(bi, bj, mi, mj int, score func(i, j int) float64, out []int) {
+	n, m := bj-bi, mj-mi
+	if n <= 0 || m <= 0 {
+		return
+	}
+	best := make([][]float64, n+1)
+	for i := range best {
+		best[i] = make([]float64, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			best[i][j] = best[i+1][j]
+			if best[i][j+1] > best[i][j] {
+				best[i][j] = best[i][j+1]
+			}
+			if s := score(bi+i, mi+j); s > 0 && best[i+1][j+1]+s > best[i][j] {
+				best[i][j] = best[i+1][j+1] + s
+			}
+		}
+	}
+	for i, j := 0, 0; i < n && j < m; {
+		switch {
+		case best[i][j] == best[i+1][j]:
+			i++
+		case best[i][j] == best[i][j+1]:
+			j++
+		default:
+			out[bi+i] = mi + j
+			i++
+			j++
+		}
+	}
+}
+
+// mergeSimilarity returns the ratio of common nodes (with the same type and values) in two
+// subtrees. Declarations and specs with a name are only similar to those with the same name.
+func mergeSimilarity(a, b dst.Node) float64 {
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return 0
+	}
+	if ka, kb := mergeKey(a), mergeKey(b); ka != "" || kb != "" {
+		if ka == kb {
+			return 1
+		}
+		return 0
+	}
+	ta, tb := newDiffTree(a), newDiffTree(b)
+	values := map[uint64]int{}
+	for _, n := range ta.nodes {
+		values[n.value]++
+	}
+	var common int
+	for _, n := range tb.nodes {
+		if values[n.value] > 0 {
+			values[n.value]--
+			common++
+		}
+	}
+	return 2 * float64(common) / float64(len(ta.nodes)+len(tb.nodes))
+}
+
+// mergeKey returns the name of a declaration or spec, or an empty string for other nodes.
+func mergeKey(n dst.Node) string {
+	switch n := n.(type) {
+	case *dst.FuncDecl:
+		if n.Recv != nil && len(n.Recv.List) > 0 {
+			return "func (" + mergeTypeName(n.Recv.List[0].Type) + ") " + n.Name.Name
+		}
+		return "func " + n.Name.Name
+	case *dst.GenDecl:
+		if n.Tok == token.IMPORT {
+			return "import"
+		}
+		if len(n.Specs) > 0 {
+			return n.Tok.String() + " " + mergeKey(n.Specs[0])
+		}
+	case *dst.ImportSpec:
+		return n.Path.Value
+	case *dst.TypeSpec:
+		return n.Name.Name
+	case *dst.ValueSpec:
+		if len(n.Names) > 0 {
+			return n.Names[0].Name
+		}
+	}
+	return ""
+}
+
+// mergeTypeName returns the name of a method receiver type.
+func mergeTypeName(e dst.Expr) string {
+	switch e := e.(type) {
+	case *dst.Ident:
+		return e.Name
+	case *dst.StarExpr:
+		return "*" + mergeTypeName(e.X)
+	case *dst.ParenExpr:
+		return mergeTypeName(e.X)
+	case *dst.IndexExpr:
+		return mergeTypeName(e.X)
+	case *dst.IndexListExpr:
+		return mergeTypeName(e.X)
+	}
+	return ""
+}
+
+// mergeShell returns a copy of n without the containers, the decorations or the fields of a File
+// that are derived from the declarations.
+func mergeShell(n dst.Node) dst.Node {
+	c := dst.Clone(n)
+	v := mergeFields(c)
+	for i := 0; i < v.NumField(); i++ {
+		switch f := v.Type().Field(i); {
+		case mergeIsContainer(f.Type), f.Name == "Decs":
+			v.Field(i).Set(reflect.Zero(f.Type))
+		}
+	}
+	if f, ok := c.(*dst.File); ok {
+		f.Imports = nil
+		f.Unresolved = nil
+	}
+	return c
+}
+
+func mergeFields(n dst.Node) reflect.Value {
+	return reflect.ValueOf(n).Elem()
+}
+
+func mergeIsNil(n dst.Node) bool {
+	return n == nil || reflect.ValueOf(n).IsNil()
+}
+
+func mergeEqual(a, b dst.Node) bool {
+	if mergeIsNil(a) || mergeIsNil(b) {
+		return mergeIsNil(a) && mergeIsNil(b)
+	}
+	return dst.Equal(a, b)
+}
+
+func mergeEqualList(a, b []dst.Node) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !mergeEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeEqualValue compares decorations and space types. Empty and nil decorations are equal.
+func mergeEqualValue(a, b reflect.Value) bool {
+	if a.Kind() == reflect.Slice {
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if a.Index(i).Interface() != b.Index(i).Interface() {
+				return false
+			}
+		}
+		return true
+	}
+	return a.Interface() == b.Interface()
+}
+
+func mergeClone(n dst.Node) dst.Node {
+	if mergeIsNil(n) {
+		return nil
+	}
+	return dst.Clone(n)
+}
+
+func mergeCloneList(in []dst.Node) []dst.Node {
+	var out []dst.Node
+	for _, n := range in {
+		out = append(out, mergeClone(n))
+	}
+	return out
+}
+
+func mergeGetNode(v reflect.Value) dst.Node {
+	if v.IsNil() {
+		return nil
+	}
+	return v.Interface().(dst.Node)
+}
+
+func mergeGetNodes(v reflect.Value) []dst.Node {
+	var out []dst.Node
+	for i := 0; i < v.Len(); i++ {
+		out = append(out, mergeGetNode(v.Index(i)))
+	}
+	return out
+}
+
+func mergeSetNode(v reflect.Value, n dst.Node) {
+	if mergeIsNil(n) {
+		v.Set(reflect.Zero(v.Type()))
+		return
+	}
+	v.Set(reflect.ValueOf(n))
+}
+
+func mergeSetNodes(v reflect.Value, in []dst.Node) {
+	if len(in) == 0 {
+		v.Set(reflect.Zero(v.Type()))
+		return
+	}
+	s := reflect.MakeSlice(v.Type(), len(in), len(in))
+	for i, n := range in {
+		mergeSetNode(s.Index(i), n)
+	}
+	v.Set(s)
+}
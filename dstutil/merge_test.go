@@ -0,0 +1,147 @@
+package dstutil_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+	"github.com/dave/dst/dstutil"
+)
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name      string
+		base      string
+		ours      string
+		theirs    string
+		expect    string
+		conflicts []string
+	}{
+		{
+			name:   "different-statements",
+			base:   "package a\n\nfunc a() {\n\tb(1)\n\tc(2)\n\td(3)\n}\n",
+			ours:   "package a\n\nfunc a() {\n\tb(10)\n\tc(2)\n\td(3)\n}\n",
+			theirs: "package a\n\nfunc a() {\n\tb(1)\n\tc(2)\n\td(30)\n}\n",
+			expect: "package a\n\nfunc a() {\n\tb(10)\n\tc(2)\n\td(30)\n}\n",
+		},
+		{
+			name:   "insert-and-delete",
+			base:   "package a\n\nfunc a() {\n\tb(1)\n\tc(2)\n\td(3)\n}\n",
+			ours:   "package a\n\nfunc a() {\n\tx()\n\tb(1)\n\tc(2)\n\td(3)\n}\n",
+			theirs: "package a\n\nfunc a() {\n\tb(1)\n\td(3)\n}\n",
+			expect: "package a\n\nfunc a() {\n\tx()\n\tb(1)\n\td(3)\n}\n",
+		},
+		{
+			name:   "different-decls",
+			base:   "package a\n\nfunc a() {\n\tb()\n}\n\nfunc c() {\n\td()\n}\n",
+			ours:   "package a\n\nfunc a() {\n\tb(1)\n}\n\nfunc c() {\n\td()\n}\n",
+			theirs: "package a\n\nfunc a() {\n\tb()\n}\n\nfunc c() {\n\td(2)\n}\n",
+			expect: "package a\n\nfunc a() {\n\tb(1)\n}\n\nfunc c() {\n\td(2)\n}\n",
+		},
+		{
+			name:   "signature-and-body",
+			base:   "package a\n\nfunc a() {\n\tb()\n\tc()\n}\n",
+			ours:   "package a\n\nfunc a(i int) {\n\tb()\n\tc()\n}\n",
+			theirs: "package a\n\nfunc a() {\n\tb()\n\tc(1)\n}\n",
+			expect: "package a\n\nfunc a(i int) {\n\tb()\n\tc(1)\n}\n",
+		},
+		{
+			name:   "nested-blocks",
+			base:   "package a\n\nfunc a() {\n\tif b {\n\t\tc()\n\t\td()\n\t}\n}\n",
+			ours:   "package a\n\nfunc a() {\n\tif b {\n\t\tc(1)\n\t\td()\n\t}\n}\n",
+			theirs: "package a\n\nfunc a() {\n\tif b {\n\t\tc()\n\t\td(2)\n\t}\n}\n",
+			expect: "package a\n\nfunc a() {\n\tif b {\n\t\tc(1)\n\t\td(2)\n\t}\n}\n",
+		},
+		{
+			name:   "decorations",
+			base:   "package a\n\nfunc a() {\n\tb()\n}\n",
+			ours:   "package a\n\n// a\nfunc a() {\n\tb()\n}\n",
+			theirs: "package a\n\nfunc a() {\n\tb() // b\n}\n",
+			expect: "package a\n\n// a\nfunc a() {\n\tb() // b\n}\n",
+		},
+		{
+			name:   "both-insert-decls",
+			base:   "package a\n\nfunc a() {}\n",
+			ours:   "package a\n\nfunc a() {}\n\nfunc b() {}\n",
+			theirs: "package a\n\nfunc a() {}\n\nfunc c() {}\n",
+			expect: "package a\n\nfunc a() {}\n\nfunc b() {}\n\nfunc c() {}\n",
+		},
+		{
+			name:   "both-add-imports",
+			base:   "package a\n\nimport (\n\t\"a\"\n)\n",
+			ours:   "package a\n\nimport (\n\t\"a\"\n\t\"b\"\n)\n",
+			theirs: "package a\n\nimport (\n\t\"a\"\n\t\"c\"\n)\n",
+			expect: "package a\n\nimport (\n\t\"a\"\n\t\"b\"\n\t\"c\"\n)\n",
+		},
+		{
+			name:      "conflict-same-statement",
+			base:      "package a\n\nfunc a() {\n\tb(1)\n\tc()\n}\n",
+			ours:      "package a\n\nfunc a() {\n\tb(2)\n\tc()\n}\n",
+			theirs:    "package a\n\nfunc a() {\n\tb(3)\n\tc()\n}\n",
+			expect:    "package a\n\nfunc a() {\n\tb(2)\n\tc()\n}\n",
+			conflicts: []string{"Decls[0].Body.List[0]: base b(1) ours b(2) theirs b(3)"},
+		},
+		{
+			name:      "conflict-insert-statements",
+			base:      "package a\n\nfunc a() {\n\tb()\n}\n",
+			ours:      "package a\n\nfunc a() {\n\tb()\n\tc()\n}\n",
+			theirs:    "package a\n\nfunc a() {\n\tb()\n\td()\n}\n",
+			expect:    "package a\n\nfunc a() {\n\tb()\n\tc()\n}\n",
+			conflicts: []string{"Decls[0].Body.List[1]: base  ours c() theirs d()"},
+		},
+		{
+			name:      "conflict-modify-delete",
+			base:      "package a\n\nfunc a() {\n\tb()\n\tc()\n}\n",
+			ours:      "package a\n\nfunc a() {\n\tb(1)\n\tc()\n}\n",
+			theirs:    "package a\n\nfunc a() {\n\tc()\n}\n",
+			expect:    "package a\n\nfunc a() {\n\tb(1)\n\tc()\n}\n",
+			conflicts: []string{"Decls[0].Body.List[0]: base b() ours b(1) theirs "},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			base, err := decorator.Parse(test.base)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ours, err := decorator.Parse(test.ours)
+			if err != nil {
+				t.Fatal(err)
+			}
+			theirs, err := decorator.Parse(test.theirs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			merged, conflicts := dstutil.Merge(base, ours, theirs)
+			buf := &bytes.Buffer{}
+			if err := decorator.Fprint(buf, merged); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != test.expect {
+				t.Errorf("expected:\n%s\nfound:\n%s", test.expect, buf.String())
+			}
+			var found []string
+			for _, c := range conflicts {
+				found = append(found, fmt.Sprintf("%s: base %s ours %s theirs %s", c.Path, printNodes(t, c.Base), printNodes(t, c.Ours), printNodes(t, c.Theirs)))
+			}
+			if strings.Join(found, "\n") != strings.Join(test.conflicts, "\n") {
+				t.Errorf("expected conflicts:\n%s\nfound:\n%s", strings.Join(test.conflicts, "\n"), strings.Join(found, "\n"))
+			}
+		})
+	}
+}
+
+func printNodes(t *testing.T, nodes []dst.Node) string {
+	var out []string
+	for _, n := range nodes {
+		buf := &bytes.Buffer{}
+		if err := decorator.FprintNode(buf, n); err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, buf.String())
+	}
+	return strings.Join(out, ", ")
+}
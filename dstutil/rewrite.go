@@ -304,7 +304,7 @@ func (a *application) apply(parent dst.Node, name string, iter *iterator, n dst.
 	case *dst.DeclStmt:
 		a.apply(n, "Decl", nil, n.Decl)
 
-	case *dst.EmptyStmt:
+	case *dst.EmptyStmt, *dst.CommentStmt:
 		// nothing to do
 
 	case *dst.LabeledStmt:
@@ -394,7 +394,7 @@ func (a *application) apply(parent dst.Node, name string, iter *iterator, n dst.
 		a.apply(n, "TypeParams", nil, n.TypeParams)
 		a.apply(n, "Type", nil, n.Type)
 
-	case *dst.BadDecl:
+	case *dst.BadDecl, *dst.CommentDecl:
 		// nothing to do
 
 	case *dst.GenDecl:
@@ -0,0 +1,126 @@
+package dstutil
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+
+	"github.com/dave/dst"
+)
+
+// Tracker records the state of a tree, so the nodes changed by a transformation (e.g. Apply) can
+// be found later. Create a Tracker with Track before making the changes, then call Changes.
+type Tracker struct {
+	root   dst.Node
+	order  []dst.Node          // the tracked nodes in the order they were visited
+	hashes map[dst.Node]uint64 // the fingerprint of each tracked node
+}
+
+// Changes lists the nodes changed since a tree was tracked. Each list is in the order of the tree
+// the nodes are in.
+type Changes struct {
+	Inserted []dst.Node // Nodes that weren't in the tree when it was tracked
+	Deleted  []dst.Node // Nodes that were in the tree when it was tracked, but aren't any more
+	Modified []dst.Node // Nodes in both trees with different values, decorations, line spacing or children
+	// If the tracked tree is a *dst.File, Decls lists the top level declarations that have been
+	// inserted, or that contain an inserted or modified node. Deleted declarations are in Deleted.
+	Decls []dst.Decl
+
+	changed map[dst.Node]bool // inserted and modified nodes, and their ancestors
+}
+
+// Track records the state of the tree at root. A fingerprint of each node is stored: the values
+// of its fields, its decorations and the identity of its children. The tree is not copied.
+func Track(root dst.Node) *Tracker {
+	t := &Tracker{root: root, hashes: map[dst.Node]uint64{}}
+	walkTracked(root, func(n dst.Node) {
+		t.order = append(t.order, n)
+		t.hashes[n] = fingerprint(n)
+	})
+	return t
+}
+
+// Changes compares the tree with its state when Track was called.
+func (t *Tracker) Changes() *Changes {
+	c := &Changes{changed: map[dst.Node]bool{}}
+	current := map[dst.Node]bool{}
+	var ancestors []dst.Node
+	var visit func(n dst.Node)
+	visit = func(n dst.Node) {
+		if current[n] {
+			return
+		}
+		current[n] = true
+		h, tracked := t.hashes[n]
+		modified := tracked && h != fingerprint(n)
+		if !tracked {
+			c.Inserted = append(c.Inserted, n)
+		} else if modified {
+			c.Modified = append(c.Modified, n)
+		}
+		if !tracked || modified {
+			c.changed[n] = true
+			for _, a := range ancestors {
+				c.changed[a] = true
+			}
+		}
+		ancestors = append(ancestors, n)
+		children(n, func(_ PathStep, child dst.Node) { visit(child) })
+		ancestors = ancestors[:len(ancestors)-1]
+	}
+	visit(t.root)
+	for _, n := range t.order {
+		if !current[n] {
+			c.Deleted = append(c.Deleted, n)
+		}
+	}
+	if f, ok := t.root.(*dst.File); ok {
+		for _, d := range f.Decls {
+			if c.changed[d] {
+				c.Decls = append(c.Decls, d)
+			}
+		}
+	}
+	return c
+}
+
+// Empty reports whether there are no changes.
+func (c *Changes) Empty() bool {
+	return len(c.Inserted) == 0 && len(c.Deleted) == 0 && len(c.Modified) == 0
+}
+
+// Changed reports whether n, or any node inside n, has been inserted or modified. Nodes that
+// aren't in the current tree return false.
+func (c *Changes) Changed(n dst.Node) bool {
+	return c.changed[n]
+}
+
+// walkTracked calls f for each node in the tree once, in depth first order.
+func walkTracked(root dst.Node, f func(n dst.Node)) {
+	seen := map[dst.Node]bool{}
+	var visit func(n dst.Node)
+	visit = func(n dst.Node) {
+		if seen[n] {
+			return
+		}
+		seen[n] = true
+		f(n)
+		children(n, func(_ PathStep, child dst.Node) { visit(child) })
+	}
+	visit(root)
+}
+
+// fingerprint hashes the values of the fields of n that aren't child nodes, its decorations
+// including the line spacing, and the identity of its children.
+func fingerprint(n dst.Node) uint64 {
+	h := fnv.New64a()
+	label, _ := nodeLabel(n)
+	fmt.Fprintf(h, "%T;%s", n, label)
+	if d := reflect.ValueOf(n).Elem().FieldByName("Decs"); d.IsValid() {
+		fmt.Fprintf(h, "%q;", d.Interface())
+	}
+	children(n, func(step PathStep, child dst.Node) {
+		fmt.Fprintf(h, "%s[%d]=%p;", step.Field, step.Index, child)
+	})
+	return h.Sum64()
+}
@@ -0,0 +1,110 @@
+package dstutil_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+	"github.com/dave/dst/dstutil"
+)
+
+func TestTrack(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   string
+		mutate func(f *dst.File)
+		expect string
+	}{
+		{
+			name:   "unchanged",
+			code:   "package a\n\nfunc a() {\n\tb()\n}\n",
+			expect: "",
+		},
+		{
+			name: "rename",
+			code: "package a\n\nfunc a() {\n\tb()\n}\n\nfunc c() {}\n",
+			mutate: func(f *dst.File) {
+				dstutil.Apply(f, func(c *dstutil.Cursor) bool {
+					if id, ok := c.Node().(*dst.Ident); ok && id.Name == "b" {
+						id.Name = "d"
+					}
+					return true
+				}, nil)
+			},
+			expect: "modified *dst.Ident d\ndecl 0",
+		},
+		{
+			name: "replace",
+			code: "package a\n\nfunc a() {\n\tb()\n}\n\nfunc c() {}\n",
+			mutate: func(f *dst.File) {
+				dstutil.Apply(f, func(c *dstutil.Cursor) bool {
+					if id, ok := c.Node().(*dst.Ident); ok && id.Name == "b" {
+						c.Replace(dst.NewIdent("d"))
+					}
+					return true
+				}, nil)
+			},
+			expect: "inserted *dst.Ident d\ndeleted *dst.Ident b\nmodified *dst.CallExpr\ndecl 0",
+		},
+		{
+			name: "decorations",
+			code: "package a\n\nvar a = 1\n\nvar b = 2\n",
+			mutate: func(f *dst.File) {
+				f.Decls[1].Decorations().Start.Append("// b")
+			},
+			expect: "modified *dst.GenDecl\ndecl 1",
+		},
+		{
+			name: "delete-decl",
+			code: "package a\n\nvar a = 1\n\nvar b = 2\n",
+			mutate: func(f *dst.File) {
+				f.Decls = f.Decls[:1]
+			},
+			expect: "deleted *dst.GenDecl\ndeleted *dst.ValueSpec\ndeleted *dst.Ident b\ndeleted *dst.BasicLit\nmodified *dst.File",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := decorator.Parse(test.code)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tracker := dstutil.Track(f)
+			if test.mutate != nil {
+				test.mutate(f)
+			}
+			c := tracker.Changes()
+			var found []string
+			describe := func(kind string, nodes []dst.Node) {
+				for _, n := range nodes {
+					s := fmt.Sprintf("%s %T", kind, n)
+					if id, ok := n.(*dst.Ident); ok {
+						s += " " + id.Name
+					}
+					found = append(found, s)
+				}
+			}
+			describe("inserted", c.Inserted)
+			describe("deleted", c.Deleted)
+			describe("modified", c.Modified)
+			for _, d := range c.Decls {
+				for i, decl := range f.Decls {
+					if decl == d {
+						found = append(found, fmt.Sprintf("decl %d", i))
+					}
+				}
+			}
+			if strings.Join(found, "\n") != test.expect {
+				t.Errorf("expected:\n%s\nfound:\n%s", test.expect, strings.Join(found, "\n"))
+			}
+			if c.Empty() != (test.expect == "") {
+				t.Errorf("expected Empty to be %v", test.expect == "")
+			}
+			if len(c.Decls) > 0 && !c.Changed(f) {
+				t.Error("expected the file to be changed")
+			}
+		})
+	}
+}
@@ -0,0 +1,520 @@
+package dstutil
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dave/dst"
+)
+
+// EditKind is the kind of an Edit.
+type EditKind int
+
+const (
+	EditInsert EditKind = iota // A node in the new tree that has no match in the old tree
+	EditDelete                 // A node in the old tree that has no match in the new tree
+	EditUpdate                 // A matched node with different values or decorations (e.g. a renamed identifier)
+	EditMove                   // A matched node with a different parent or position among its siblings
+)
+
+func (k EditKind) String() string {
+	switch k {
+	case EditInsert:
+		return "insert"
+	case EditDelete:
+		return "delete"
+	case EditUpdate:
+		return "update"
+	case EditMove:
+		return "move"
+	}
+	return ""
+}
+
+// PathStep is a step from a node to one of its children.
+type PathStep struct {
+	Field string // The name of the field in the parent node
+	Index int    // The index in the field if it is a slice, or -1
+}
+
+// Path is the location of a node relative to the root of a tree.
+type Path []PathStep
+
+func (p Path) String() string {
+	var parts []string
+	for _, step := range p {
+		if step.Index < 0 {
+			parts = append(parts, step.Field)
+		} else {
+			parts = append(parts, step.Field+"["+strconv.Itoa(step.Index)+"]")
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// Node returns the node at the path from root, or nil if the path doesn't exist in the tree.
+func (p Path) Node(root dst.Node) dst.Node {
+	n := root
+	for _, step := range p {
+		if n == nil || reflect.ValueOf(n).IsNil() {
+			return nil
+		}
+		v := reflect.ValueOf(n).Elem().FieldByName(step.Field)
+		if !v.IsValid() {
+			return nil
+		}
+		if step.Index >= 0 {
+			if v.Kind() != reflect.Slice || step.Index >= v.Len() {
+				return nil
+			}
+			v = v.Index(step.Index)
+		}
+		child, ok := v.Interface().(dst.Node)
+		if !ok {
+			return nil
+		}
+		n = child
+	}
+	return n
+}
+
+// Edit is a node-level operation in an edit script returned by TreeDiff.
+type Edit struct {
+	Kind    EditKind
+	Old     dst.Node // The node in the old tree (nil for EditInsert)
+	New     dst.Node // The node in the new tree (nil for EditDelete)
+	OldPath Path     // The path of Old from the root of the old tree
+	NewPath Path     // The path of New from the root of the new tree
+}
+
+func (e Edit) String() string {
+	switch e.Kind {
+	case EditInsert:
+		return fmt.Sprintf("insert %T at %s", e.New, e.NewPath)
+	case EditDelete:
+		return fmt.Sprintf("delete %T at %s", e.Old, e.OldPath)
+	case EditUpdate:
+		return fmt.Sprintf("update %T at %s", e.New, e.NewPath)
+	case EditMove:
+		return fmt.Sprintf("move %T from %s to %s", e.New, e.OldPath, e.NewPath)
+	}
+	return ""
+}
+
+// TreeDiff computes an edit script that transforms the old file into the new file. Nodes are
+// matched using an algorithm based on GumTree: first the largest identical subtrees are matched
+// (ignoring decorations), then container nodes with many matched descendants, then remaining
+// children of matched containers. Changes to line spacing are ignored. Insert and Delete edits are
+// only returned for the root of each inserted or deleted subtree. Inserts, updates and moves are
+// returned in the order of the new tree, followed by deletes in the order of the old tree.
+func TreeDiff(old, new *dst.File) []Edit {
+	d := &differ{
+		old: newDiffTree(old),
+		new: newDiffTree(new),
+	}
+	d.topDown()
+	d.bottomUp()
+	return d.script()
+}
+
+// minHeight is the minimum height of ambiguous identical subtrees that are matched in the
+// top-down phase. Smaller subtrees (e.g. identifiers) are matched later, using their context.
+const minHeight = 2
+
+// minDice is the minimum ratio of common descendants for container nodes to be matched in the
+// bottom-up phase.
+const minDice = 0.5
+
+type diffNode struct {
+	node     dst.Node
+	parent   *diffNode
+	step     PathStep
+	children []*diffNode
+	height   int
+	size     int    // number of descendants
+	hash     uint64 // hash of the subtree, ignoring decorations
+	value    uint64 // hash of the type and label of the node, ignoring decorations
+	label    string // values of the node, excluding children
+	order    int    // pre-order index
+	partner  *diffNode
+}
+
+func (n *diffNode) path() Path {
+	if n.parent == nil {
+		return nil
+	}
+	return append(n.parent.path(), n.step)
+}
+
+// isDescendantOf reports whether n is a (strict) descendant of ancestor.
+func (n *diffNode) isDescendantOf(ancestor *diffNode) bool {
+	for p := n.parent; p != nil; p = p.parent {
+		if p == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *diffNode) descendants(f func(*diffNode)) {
+	for _, c := range n.children {
+		f(c)
+		c.descendants(f)
+	}
+}
+
+type diffTree struct {
+	root  *diffNode
+	nodes []*diffNode // in pre-order
+}
+
+func newDiffTree(root dst.Node) *diffTree {
+	t := &diffTree{}
+	t.root = t.add(nil, PathStep{}, root)
+	return t
+}
+
+func (t *diffTree) add(parent *diffNode, step PathStep, n dst.Node) *diffNode {
+	dn := &diffNode{node: n, parent: parent, step: step, order: len(t.nodes)}
+	t.nodes = append(t.nodes, dn)
+	h := fnv.New64a()
+	label, decs := nodeLabel(n)
+	fmt.Fprintf(h, "%T;%s;", n, label)
+	dn.value = h.Sum64()
+	dn.label = label + decs
+	dn.height = 1
+	children(n, func(step PathStep, c dst.Node) {
+		child := t.add(dn, step, c)
+		dn.children = append(dn.children, child)
+		if child.height+1 > dn.height {
+			dn.height = child.height + 1
+		}
+		dn.size += child.size + 1
+		fmt.Fprintf(h, "%s:%d;", step.Field, child.hash)
+	})
+	dn.hash = h.Sum64()
+	return dn
+}
+
+var nodeType = reflect.TypeOf((*dst.Node)(nil)).Elem()
+
+// children calls f for each child of n in the order of the struct fields.
+func children(n dst.Node, f func(step PathStep, c dst.Node)) {
+	v := reflect.ValueOf(n).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		name := v.Type().Field(i).Name
+		switch {
+		case field.Kind() == reflect.Slice && field.Type().Elem().Implements(nodeType):
+			for j := 0; j < field.Len(); j++ {
+				if !field.Index(j).IsNil() {
+					f(PathStep{Field: name, Index: j}, field.Index(j).Interface().(dst.Node))
+				}
+			}
+		case field.Type().Implements(nodeType):
+			if !field.IsNil() {
+				f(PathStep{Field: name, Index: -1}, field.Interface().(dst.Node))
+			}
+		}
+	}
+}
+
+// nodeLabel returns the values of the fields of n that are not child nodes, objects or scopes,
+// and the decorations. The line spacing is excluded from the decorations, because it often
+// changes when neighbouring nodes are inserted or deleted.
+func nodeLabel(n dst.Node) (label, decs string) {
+	v := reflect.ValueOf(n).Elem()
+	sb := &strings.Builder{}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		switch field.Kind() {
+		case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			fmt.Fprintf(sb, "%s=%v;", v.Type().Field(i).Name, field.Interface())
+		}
+	}
+	if d := v.FieldByName("Decs"); d.IsValid() {
+		c := reflect.New(d.Type()).Elem()
+		c.Set(d)
+		c.FieldByName("Before").SetInt(0)
+		c.FieldByName("After").SetInt(0)
+		decs = fmt.Sprint(c.Interface())
+	}
+	return sb.String(), decs
+}
+
+type differ struct {
+	old, new *diffTree
+}
+
+func (d *differ) match(o, n *diffNode) {
+	o.partner = n
+	n.partner = o
+}
+
+// matchSubtree matches all the nodes of two identical subtrees.
+func (d *differ) matchSubtree(o, n *diffNode) {
+	d.match(o, n)
+	for i := range o.children {
+		d.matchSubtree(o.children[i], n.children[i])
+	}
+}
+
+// isomorphic reports whether two subtrees are identical, ignoring decorations.
+func isomorphic(o, n *diffNode) bool {
+	return o.hash == n.hash && len(o.children) == len(n.children) && dst.Equal(o.node, n.node, dst.IgnoreDecorations(), dst.IgnoreSpacing())
+}
+
+// topDown matches the largest identical subtrees. Subtrees that are unique in both trees are
+// matched first, then ambiguous subtrees are matched preferring those with similar parents.
+func (d *differ) topDown() {
+	type pair struct {
+		o, n  *diffNode
+		score float64 // the dice similarity of the parents
+	}
+	var ambiguous []pair
+
+	maxHeight := d.old.root.height
+	if d.new.root.height > maxHeight {
+		maxHeight = d.new.root.height
+	}
+	for height := maxHeight; height >= 1; height-- {
+		olds := map[uint64][]*diffNode{}
+		news := map[uint64][]*diffNode{}
+		var hashes []uint64
+		for _, o := range d.old.nodes {
+			if o.height == height && o.partner == nil {
+				olds[o.hash] = append(olds[o.hash], o)
+			}
+		}
+		for _, n := range d.new.nodes {
+			if n.height == height && n.partner == nil {
+				if len(news[n.hash]) == 0 {
+					hashes = append(hashes, n.hash)
+				}
+				news[n.hash] = append(news[n.hash], n)
+			}
+		}
+		for _, h := range hashes {
+			o, n := olds[h], news[h]
+			switch {
+			case len(o) == 0:
+				continue
+			case len(o) == 1 && len(n) == 1:
+				if o[0].partner == nil && n[0].partner == nil && isomorphic(o[0], n[0]) {
+					d.matchSubtree(o[0], n[0])
+				}
+			case height >= minHeight:
+				for _, on := range o {
+					for _, nn := range n {
+						ambiguous = append(ambiguous, pair{o: on, n: nn})
+					}
+				}
+			}
+		}
+	}
+
+	// score each pair once: dice walks the descendants, so it's too slow to call in the comparator
+	for i, p := range ambiguous {
+		if p.o.parent != nil && p.n.parent != nil {
+			ambiguous[i].score = d.dice(p.o.parent, p.n.parent)
+		}
+	}
+	sort.SliceStable(ambiguous, func(i, j int) bool {
+		a, b := ambiguous[i], ambiguous[j]
+		if a.o.height != b.o.height {
+			return a.o.height > b.o.height
+		}
+		if a.score != b.score {
+			return a.score > b.score
+		}
+		return abs(a.o.order-a.n.order) < abs(b.o.order-b.n.order)
+	})
+	for _, p := range ambiguous {
+		if p.o.partner == nil && p.n.partner == nil && isomorphic(p.o, p.n) {
+			d.matchSubtree(p.o, p.n)
+		}
+	}
+}
+
+// bottomUp matches container nodes that have a large ratio of matched descendants, and then
+// matches the remaining children of matched containers.
+func (d *differ) bottomUp() {
+	var postOrder func(n *diffNode, f func(*diffNode))
+	postOrder = func(n *diffNode, f func(*diffNode)) {
+		for _, c := range n.children {
+			postOrder(c, f)
+		}
+		f(n)
+	}
+	postOrder(d.old.root, func(o *diffNode) {
+		if o.partner != nil || o.parent == nil {
+			return
+		}
+		var best *diffNode
+		var bestDice float64
+		seen := map[*diffNode]bool{}
+		o.descendants(func(c *diffNode) {
+			if c.partner == nil {
+				return
+			}
+			for n := c.partner.parent; n != nil; n = n.parent {
+				if seen[n] {
+					break
+				}
+				seen[n] = true
+				if n.partner != nil || reflect.TypeOf(n.node) != reflect.TypeOf(o.node) {
+					continue
+				}
+				if dice := d.dice(o, n); dice >= minDice && dice > bestDice {
+					best, bestDice = n, dice
+				}
+			}
+		})
+		if best != nil {
+			d.match(o, best)
+			d.recover(o, best)
+		}
+	})
+	if d.old.root.partner == nil && d.new.root.partner == nil {
+		d.match(d.old.root, d.new.root)
+	}
+	d.recover(d.old.root, d.new.root)
+}
+
+// recover matches the unmatched children of matched nodes: first identical children in the same
+// field, then children of the same type at the same position.
+func (d *differ) recover(o, n *diffNode) {
+	for _, nc := range n.children {
+		if nc.partner != nil {
+			continue
+		}
+		for _, oc := range o.children {
+			if oc.partner == nil && oc.step.Field == nc.step.Field && isomorphic(oc, nc) {
+				d.matchSubtree(oc, nc)
+				break
+			}
+		}
+	}
+	for _, nc := range n.children {
+		if nc.partner != nil {
+			continue
+		}
+		for _, oc := range o.children {
+			if oc.partner == nil && oc.step == nc.step && reflect.TypeOf(oc.node) == reflect.TypeOf(nc.node) {
+				d.match(oc, nc)
+				d.recover(oc, nc)
+				break
+			}
+		}
+	}
+}
+
+// dice returns the ratio of common descendants of o and n.
+func (d *differ) dice(o, n *diffNode) float64 {
+	if o.size+n.size == 0 {
+		return 0
+	}
+	var common int
+	o.descendants(func(c *diffNode) {
+		if c.partner != nil && c.partner.isDescendantOf(n) {
+			common++
+		}
+	})
+	return 2 * float64(common) / float64(o.size+n.size)
+}
+
+// script returns the edit script from the matched trees.
+func (d *differ) script() []Edit {
+	moved := d.misordered()
+	var edits []Edit
+	for _, n := range d.new.nodes {
+		o := n.partner
+		if o == nil {
+			if n.parent == nil || n.parent.partner != nil {
+				edits = append(edits, Edit{Kind: EditInsert, New: n.node, NewPath: n.path()})
+			}
+			continue
+		}
+		if o.label != n.label {
+			edits = append(edits, Edit{Kind: EditUpdate, Old: o.node, New: n.node, OldPath: o.path(), NewPath: n.path()})
+		}
+		if n.parent == nil {
+			continue
+		}
+		if o.parent == nil || o.parent.partner != n.parent || o.step.Field != n.step.Field || moved[n] {
+			edits = append(edits, Edit{Kind: EditMove, Old: o.node, New: n.node, OldPath: o.path(), NewPath: n.path()})
+		}
+	}
+	for _, o := range d.old.nodes {
+		if o.partner == nil && (o.parent == nil || o.parent.partner != nil) {
+			edits = append(edits, Edit{Kind: EditDelete, Old: o.node, OldPath: o.path()})
+		}
+	}
+	return edits
+}
+
+// misordered returns the nodes that stay in the same list but change their order relative to
+// their siblings. The longest sequence of siblings that keep their order is not moved.
+func (d *differ) misordered() map[*diffNode]bool {
+	moved := map[*diffNode]bool{}
+	for _, n := range d.new.nodes {
+		if n.partner == nil {
+			continue
+		}
+		lists := map[string][]*diffNode{}
+		var fields []string
+		for _, c := range n.children {
+			if c.step.Index < 0 || c.partner == nil || c.partner.parent != n.partner || c.partner.step.Field != c.step.Field {
+				continue
+			}
+			if len(lists[c.step.Field]) == 0 {
+				fields = append(fields, c.step.Field)
+			}
+			lists[c.step.Field] = append(lists[c.step.Field], c)
+		}
+		for _, field := range fields {
+			list := lists[field]
+			keep := increasing(list)
+			for _, c := range list {
+				if !keep[c] {
+					moved[c] = true
+				}
+			}
+		}
+	}
+	return moved
+}
+
+// increasing returns the longest subsequence of nodes whose partners are in increasing order.
+func increasing(list []*diffNode) map[*diffNode]bool {
+	length := make([]int, len(list))
+	prev := make([]int, len(list))
+	best := -1
+	for i := range list {
+		length[i], prev[i] = 1, -1
+		for j := 0; j < i; j++ {
+			if list[j].partner.step.Index < list[i].partner.step.Index && length[j]+1 > length[i] {
+				length[i], prev[i] = length[j]+1, j
+			}
+		}
+		if best == -1 || length[i] > length[best] {
+			best = i
+		}
+	}
+	keep := map[*diffNode]bool{}
+	for i := best; i >= 0; i = prev[i] {
+		keep[list[i]] = true
+	}
+	return keep
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
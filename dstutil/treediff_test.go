@@ -0,0 +1,114 @@
+package dstutil_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+	"github.com/dave/dst/dstutil"
+)
+
+func TestTreeDiff(t *testing.T) {
+	tests := []struct {
+		name   string
+		old    string
+		new    string
+		expect []string
+	}{
+		{
+			name: "same",
+			old:  "package a\n\nfunc a() {\n\tb()\n}\n",
+			new:  "package a\n\nfunc a() {\n\tb()\n}\n",
+		},
+		{
+			name: "update-ident",
+			old:  "package a\n\nfunc a() {\n\tb(c, d)\n}\n",
+			new:  "package a\n\nfunc a() {\n\tb(c, e)\n}\n",
+			expect: []string{
+				"update *dst.Ident at Decls[0].Body.List[0].X.Args[1]",
+			},
+		},
+		{
+			name: "update-comment",
+			old:  "package a\n\nfunc a() {\n\tb() // b\n}\n",
+			new:  "package a\n\nfunc a() {\n\tb() // c\n}\n",
+			expect: []string{
+				"update *dst.ExprStmt at Decls[0].Body.List[0]",
+			},
+		},
+		{
+			name: "insert",
+			old:  "package a\n\nfunc a() {\n\tb()\n\tc()\n}\n",
+			new:  "package a\n\nfunc a() {\n\tb()\n\td(1)\n\tc()\n}\n",
+			expect: []string{
+				"insert *dst.ExprStmt at Decls[0].Body.List[1]",
+			},
+		},
+		{
+			name: "delete",
+			old:  "package a\n\nfunc a() {\n\tb()\n\td(1)\n\tc()\n}\n",
+			new:  "package a\n\nfunc a() {\n\tb()\n\tc()\n}\n",
+			expect: []string{
+				"delete *dst.ExprStmt at Decls[0].Body.List[1]",
+			},
+		},
+		{
+			name: "move-within-list",
+			old:  "package a\n\nfunc a() {\n\tb(1)\n\tc(2)\n\td(3)\n}\n",
+			new:  "package a\n\nfunc a() {\n\td(3)\n\tb(1)\n\tc(2)\n}\n",
+			expect: []string{
+				"move *dst.ExprStmt from Decls[0].Body.List[2] to Decls[0].Body.List[0]",
+			},
+		},
+		{
+			name: "move-to-other-parent",
+			old:  "package a\n\nfunc a() {\n\tb(1)\n\tif c {\n\t\td(2)\n\t}\n}\n",
+			new:  "package a\n\nfunc a() {\n\tif c {\n\t\td(2)\n\t\tb(1)\n\t}\n}\n",
+			expect: []string{
+				"move *dst.ExprStmt from Decls[0].Body.List[0] to Decls[0].Body.List[0].Body.List[1]",
+			},
+		},
+		{
+			name: "insert-decl",
+			old:  "package a\n\nfunc a() {}\n",
+			new:  "package a\n\nfunc a() {}\n\nfunc b() {}\n",
+			expect: []string{
+				"insert *dst.FuncDecl at Decls[1]",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			old, err := decorator.Parse(test.old)
+			if err != nil {
+				t.Fatal(err)
+			}
+			new, err := decorator.Parse(test.new)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var found []string
+			for _, e := range dstutil.TreeDiff(old, new) {
+				found = append(found, e.String())
+			}
+			if strings.Join(found, "\n") != strings.Join(test.expect, "\n") {
+				t.Errorf("expected:\n%s\nfound:\n%s", strings.Join(test.expect, "\n"), strings.Join(found, "\n"))
+			}
+		})
+	}
+}
+
+func TestPathNode(t *testing.T) {
+	f, err := decorator.Parse("package a\n\nfunc a() {\n\tb(c, d)\n}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := dstutil.Path{{"Decls", 0}, {"Body", -1}, {"List", 0}, {"X", -1}, {"Args", 1}}
+	if id, ok := path.Node(f).(*dst.Ident); !ok || id.Name != "d" {
+		t.Errorf("unexpected node %#v", path.Node(f))
+	}
+	if n := (dstutil.Path{{"Decls", 1}}).Node(f); n != nil {
+		t.Errorf("expected nil, found %#v", n)
+	}
+}
@@ -0,0 +1,4986 @@
+package dst
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// equal reports whether a and b represent the same code.
+func (c *equalConfig) equal(a, b Node) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if equal, ok := c.equalQualified(a, b); ok {
+		return equal
+	}
+	switch a := a.(type) {
+	case *ArrayType:
+		b, ok := b.(*ArrayType)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Lbrack
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Lbrack, b.Decs.Lbrack) {
+			return false
+		}
+
+		// Node: Len
+		if !c.equal(a.Len, b.Len) {
+			return false
+		}
+
+		// Decoration: Len
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Len, b.Decs.Len) {
+			return false
+		}
+
+		// Node: Elt
+		if !c.equal(a.Elt, b.Elt) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *AssignStmt:
+		b, ok := b.(*AssignStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// List: Lhs
+		if len(a.Lhs) != len(b.Lhs) {
+			return false
+		}
+		for i, v := range a.Lhs {
+			if !c.equal(v, b.Lhs[i]) {
+				return false
+			}
+		}
+
+		// Token: Tok
+		if a.Tok != b.Tok {
+			return false
+		}
+
+		// Decoration: Tok
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Tok, b.Decs.Tok) {
+			return false
+		}
+
+		// List: Rhs
+		if len(a.Rhs) != len(b.Rhs) {
+			return false
+		}
+		for i, v := range a.Rhs {
+			if !c.equal(v, b.Rhs[i]) {
+				return false
+			}
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *BadDecl:
+		b, ok := b.(*BadDecl)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Bad
+		if a.Length != b.Length {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *BadExpr:
+		b, ok := b.(*BadExpr)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Bad
+		if a.Length != b.Length {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *BadStmt:
+		b, ok := b.(*BadStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Bad
+		if a.Length != b.Length {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *BasicLit:
+		b, ok := b.(*BasicLit)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// String: Value
+		if a.Value != b.Value {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		// Value: Kind
+		if a.Kind != b.Kind {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *BinaryExpr:
+		b, ok := b.(*BinaryExpr)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: X
+		if !c.equal(a.X, b.X) {
+			return false
+		}
+
+		// Decoration: X
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.X, b.Decs.X) {
+			return false
+		}
+
+		// Token: Op
+		if a.Op != b.Op {
+			return false
+		}
+
+		// Decoration: Op
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Op, b.Decs.Op) {
+			return false
+		}
+
+		// Node: Y
+		if !c.equal(a.Y, b.Y) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *BlockStmt:
+		b, ok := b.(*BlockStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Lbrace
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Lbrace, b.Decs.Lbrace) {
+			return false
+		}
+
+		// List: List
+		if len(a.List) != len(b.List) {
+			return false
+		}
+		for i, v := range a.List {
+			if !c.equal(v, b.List[i]) {
+				return false
+			}
+		}
+
+		// Token: Rbrace
+		if a.RbraceHasNoPos != b.RbraceHasNoPos {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *BranchStmt:
+		b, ok := b.(*BranchStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Token: Tok
+		if a.Tok != b.Tok {
+			return false
+		}
+
+		// Decoration: Tok
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Tok, b.Decs.Tok) {
+			return false
+		}
+
+		// Node: Label
+		if !c.equal(a.Label, b.Label) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *CallExpr:
+		b, ok := b.(*CallExpr)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: Fun
+		if !c.equal(a.Fun, b.Fun) {
+			return false
+		}
+
+		// Decoration: Fun
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Fun, b.Decs.Fun) {
+			return false
+		}
+
+		// Decoration: Lparen
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Lparen, b.Decs.Lparen) {
+			return false
+		}
+
+		// List: Args
+		if len(a.Args) != len(b.Args) {
+			return false
+		}
+		for i, v := range a.Args {
+			if !c.equal(v, b.Args[i]) {
+				return false
+			}
+		}
+
+		// Token: Ellipsis
+		if a.Ellipsis != b.Ellipsis {
+			return false
+		}
+
+		// Decoration: Ellipsis
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Ellipsis, b.Decs.Ellipsis) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *CaseClause:
+		b, ok := b.(*CaseClause)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Case
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Case, b.Decs.Case) {
+			return false
+		}
+
+		// List: List
+		if len(a.List) != len(b.List) {
+			return false
+		}
+		for i, v := range a.List {
+			if !c.equal(v, b.List[i]) {
+				return false
+			}
+		}
+
+		// Decoration: Colon
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Colon, b.Decs.Colon) {
+			return false
+		}
+
+		// List: Body
+		if len(a.Body) != len(b.Body) {
+			return false
+		}
+		for i, v := range a.Body {
+			if !c.equal(v, b.Body[i]) {
+				return false
+			}
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *ChanType:
+		b, ok := b.(*ChanType)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Begin
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Begin, b.Decs.Begin) {
+			return false
+		}
+
+		// Decoration: Arrow
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Arrow, b.Decs.Arrow) {
+			return false
+		}
+
+		// Node: Value
+		if !c.equal(a.Value, b.Value) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		// Value: Dir
+		if a.Dir != b.Dir {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *CommClause:
+		b, ok := b.(*CommClause)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Case
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Case, b.Decs.Case) {
+			return false
+		}
+
+		// Node: Comm
+		if !c.equal(a.Comm, b.Comm) {
+			return false
+		}
+
+		// Decoration: Comm
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Comm, b.Decs.Comm) {
+			return false
+		}
+
+		// Decoration: Colon
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Colon, b.Decs.Colon) {
+			return false
+		}
+
+		// List: Body
+		if len(a.Body) != len(b.Body) {
+			return false
+		}
+		for i, v := range a.Body {
+			if !c.equal(v, b.Body[i]) {
+				return false
+			}
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *CommentDecl:
+		b, ok := b.(*CommentDecl)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *CommentStmt:
+		b, ok := b.(*CommentStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *CompositeLit:
+		b, ok := b.(*CompositeLit)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: Type
+		if !c.equal(a.Type, b.Type) {
+			return false
+		}
+
+		// Decoration: Type
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Type, b.Decs.Type) {
+			return false
+		}
+
+		// Decoration: Lbrace
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Lbrace, b.Decs.Lbrace) {
+			return false
+		}
+
+		// List: Elts
+		if len(a.Elts) != len(b.Elts) {
+			return false
+		}
+		for i, v := range a.Elts {
+			if !c.equal(v, b.Elts[i]) {
+				return false
+			}
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		// Value: Incomplete
+		if a.Incomplete != b.Incomplete {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *DeclStmt:
+		b, ok := b.(*DeclStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: Decl
+		if !c.equal(a.Decl, b.Decl) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *DeferStmt:
+		b, ok := b.(*DeferStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Defer
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Defer, b.Decs.Defer) {
+			return false
+		}
+
+		// Node: Call
+		if !c.equal(a.Call, b.Call) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *Ellipsis:
+		b, ok := b.(*Ellipsis)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Ellipsis
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Ellipsis, b.Decs.Ellipsis) {
+			return false
+		}
+
+		// Node: Elt
+		if !c.equal(a.Elt, b.Elt) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *EmptyStmt:
+		b, ok := b.(*EmptyStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		// Value: Implicit
+		if a.Implicit != b.Implicit {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *ExprStmt:
+		b, ok := b.(*ExprStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: X
+		if !c.equal(a.X, b.X) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *Field:
+		b, ok := b.(*Field)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// List: Names
+		if len(a.Names) != len(b.Names) {
+			return false
+		}
+		for i, v := range a.Names {
+			if !c.equal(v, b.Names[i]) {
+				return false
+			}
+		}
+
+		// Node: Type
+		if !c.equal(a.Type, b.Type) {
+			return false
+		}
+
+		// Decoration: Type
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Type, b.Decs.Type) {
+			return false
+		}
+
+		// Node: Tag
+		if !c.equal(a.Tag, b.Tag) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *FieldList:
+		b, ok := b.(*FieldList)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Token: Opening
+		if a.Opening != b.Opening {
+			return false
+		}
+
+		// Decoration: Opening
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Opening, b.Decs.Opening) {
+			return false
+		}
+
+		// List: List
+		if len(a.List) != len(b.List) {
+			return false
+		}
+		for i, v := range a.List {
+			if !c.equal(v, b.List[i]) {
+				return false
+			}
+		}
+
+		// Token: Closing
+		if a.Closing != b.Closing {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *File:
+		b, ok := b.(*File)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Package
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Package, b.Decs.Package) {
+			return false
+		}
+
+		// Node: Name
+		if !c.equal(a.Name, b.Name) {
+			return false
+		}
+
+		// Decoration: Name
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Name, b.Decs.Name) {
+			return false
+		}
+
+		// List: Decls
+		if len(a.Decls) != len(b.Decls) {
+			return false
+		}
+		for i, v := range a.Decls {
+			if !c.equal(v, b.Decls[i]) {
+				return false
+			}
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		// List: Imports
+		if len(a.Imports) != len(b.Imports) {
+			return false
+		}
+		for i, v := range a.Imports {
+			if !c.equal(v, b.Imports[i]) {
+				return false
+			}
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *ForStmt:
+		b, ok := b.(*ForStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: For
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.For, b.Decs.For) {
+			return false
+		}
+
+		// Node: Init
+		if !c.equal(a.Init, b.Init) {
+			return false
+		}
+
+		// Decoration: Init
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Init, b.Decs.Init) {
+			return false
+		}
+
+		// Node: Cond
+		if !c.equal(a.Cond, b.Cond) {
+			return false
+		}
+
+		// Decoration: Cond
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Cond, b.Decs.Cond) {
+			return false
+		}
+
+		// Node: Post
+		if !c.equal(a.Post, b.Post) {
+			return false
+		}
+
+		// Decoration: Post
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Post, b.Decs.Post) {
+			return false
+		}
+
+		// Node: Body
+		if !c.equal(a.Body, b.Body) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *FuncDecl:
+		b, ok := b.(*FuncDecl)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Init: Type
+		if !c.equal(a.Type, b.Type) {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Func
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Func, b.Decs.Func) {
+			return false
+		}
+
+		// Node: Recv
+		if !c.equal(a.Recv, b.Recv) {
+			return false
+		}
+
+		// Decoration: Recv
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Recv, b.Decs.Recv) {
+			return false
+		}
+
+		// Node: Name
+		if !c.equal(a.Name, b.Name) {
+			return false
+		}
+
+		// Decoration: Name
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Name, b.Decs.Name) {
+			return false
+		}
+
+		// Decoration: TypeParams
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.TypeParams, b.Decs.TypeParams) {
+			return false
+		}
+
+		// Decoration: Params
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Params, b.Decs.Params) {
+			return false
+		}
+
+		// Decoration: Results
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Results, b.Decs.Results) {
+			return false
+		}
+
+		// Node: Body
+		if !c.equal(a.Body, b.Body) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *FuncLit:
+		b, ok := b.(*FuncLit)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: Type
+		if !c.equal(a.Type, b.Type) {
+			return false
+		}
+
+		// Decoration: Type
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Type, b.Decs.Type) {
+			return false
+		}
+
+		// Node: Body
+		if !c.equal(a.Body, b.Body) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *FuncType:
+		b, ok := b.(*FuncType)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Token: Func
+		if a.Func != b.Func {
+			return false
+		}
+
+		// Decoration: Func
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Func, b.Decs.Func) {
+			return false
+		}
+
+		// Node: TypeParams
+		if !c.equal(a.TypeParams, b.TypeParams) {
+			return false
+		}
+
+		// Decoration: TypeParams
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.TypeParams, b.Decs.TypeParams) {
+			return false
+		}
+
+		// Node: Params
+		if !c.equal(a.Params, b.Params) {
+			return false
+		}
+
+		// Decoration: Params
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Params, b.Decs.Params) {
+			return false
+		}
+
+		// Node: Results
+		if !c.equal(a.Results, b.Results) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *GenDecl:
+		b, ok := b.(*GenDecl)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Token: Tok
+		if a.Tok != b.Tok {
+			return false
+		}
+
+		// Decoration: Tok
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Tok, b.Decs.Tok) {
+			return false
+		}
+
+		// Token: Lparen
+		if a.Lparen != b.Lparen {
+			return false
+		}
+
+		// Decoration: Lparen
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Lparen, b.Decs.Lparen) {
+			return false
+		}
+
+		// List: Specs
+		if len(a.Specs) != len(b.Specs) {
+			return false
+		}
+		for i, v := range a.Specs {
+			if !c.equal(v, b.Specs[i]) {
+				return false
+			}
+		}
+
+		// Token: Rparen
+		if a.Rparen != b.Rparen {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *GoStmt:
+		b, ok := b.(*GoStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Go
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Go, b.Decs.Go) {
+			return false
+		}
+
+		// Node: Call
+		if !c.equal(a.Call, b.Call) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *Ident:
+		b, ok := b.(*Ident)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: X
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.X, b.Decs.X) {
+			return false
+		}
+
+		// String: Name
+		if a.Name != b.Name {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		// Path: Path
+		if a.Path != b.Path && (!c.ignorePath || a.Path != "" && b.Path != "") {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *IfStmt:
+		b, ok := b.(*IfStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: If
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.If, b.Decs.If) {
+			return false
+		}
+
+		// Node: Init
+		if !c.equal(a.Init, b.Init) {
+			return false
+		}
+
+		// Decoration: Init
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Init, b.Decs.Init) {
+			return false
+		}
+
+		// Node: Cond
+		if !c.equal(a.Cond, b.Cond) {
+			return false
+		}
+
+		// Decoration: Cond
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Cond, b.Decs.Cond) {
+			return false
+		}
+
+		// Node: Body
+		if !c.equal(a.Body, b.Body) {
+			return false
+		}
+
+		// Decoration: Else
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Else, b.Decs.Else) {
+			return false
+		}
+
+		// Node: Else
+		if !c.equal(a.Else, b.Else) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *ImportSpec:
+		b, ok := b.(*ImportSpec)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: Name
+		if !c.equal(a.Name, b.Name) {
+			return false
+		}
+
+		// Decoration: Name
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Name, b.Decs.Name) {
+			return false
+		}
+
+		// Node: Path
+		if !c.equal(a.Path, b.Path) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *IncDecStmt:
+		b, ok := b.(*IncDecStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: X
+		if !c.equal(a.X, b.X) {
+			return false
+		}
+
+		// Decoration: X
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.X, b.Decs.X) {
+			return false
+		}
+
+		// Token: Tok
+		if a.Tok != b.Tok {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *IndexExpr:
+		b, ok := b.(*IndexExpr)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: X
+		if !c.equal(a.X, b.X) {
+			return false
+		}
+
+		// Decoration: X
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.X, b.Decs.X) {
+			return false
+		}
+
+		// Decoration: Lbrack
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Lbrack, b.Decs.Lbrack) {
+			return false
+		}
+
+		// Node: Index
+		if !c.equal(a.Index, b.Index) {
+			return false
+		}
+
+		// Decoration: Index
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Index, b.Decs.Index) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *IndexListExpr:
+		b, ok := b.(*IndexListExpr)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: X
+		if !c.equal(a.X, b.X) {
+			return false
+		}
+
+		// Decoration: X
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.X, b.Decs.X) {
+			return false
+		}
+
+		// Decoration: Lbrack
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Lbrack, b.Decs.Lbrack) {
+			return false
+		}
+
+		// List: Indices
+		if len(a.Indices) != len(b.Indices) {
+			return false
+		}
+		for i, v := range a.Indices {
+			if !c.equal(v, b.Indices[i]) {
+				return false
+			}
+		}
+
+		// Decoration: Indices
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Indices, b.Decs.Indices) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *InterfaceType:
+		b, ok := b.(*InterfaceType)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Interface
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Interface, b.Decs.Interface) {
+			return false
+		}
+
+		// Node: Methods
+		if !c.equal(a.Methods, b.Methods) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		// Value: Incomplete
+		if a.Incomplete != b.Incomplete {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *KeyValueExpr:
+		b, ok := b.(*KeyValueExpr)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: Key
+		if !c.equal(a.Key, b.Key) {
+			return false
+		}
+
+		// Decoration: Key
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Key, b.Decs.Key) {
+			return false
+		}
+
+		// Decoration: Colon
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Colon, b.Decs.Colon) {
+			return false
+		}
+
+		// Node: Value
+		if !c.equal(a.Value, b.Value) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *LabeledStmt:
+		b, ok := b.(*LabeledStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: Label
+		if !c.equal(a.Label, b.Label) {
+			return false
+		}
+
+		// Decoration: Label
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Label, b.Decs.Label) {
+			return false
+		}
+
+		// Decoration: Colon
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Colon, b.Decs.Colon) {
+			return false
+		}
+
+		// Node: Stmt
+		if !c.equal(a.Stmt, b.Stmt) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *MapType:
+		b, ok := b.(*MapType)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Map
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Map, b.Decs.Map) {
+			return false
+		}
+
+		// Node: Key
+		if !c.equal(a.Key, b.Key) {
+			return false
+		}
+
+		// Decoration: Key
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Key, b.Decs.Key) {
+			return false
+		}
+
+		// Node: Value
+		if !c.equal(a.Value, b.Value) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *Package:
+		b, ok := b.(*Package)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		// Value: Name
+		if a.Name != b.Name {
+			return false
+		}
+
+		// Map: Files
+		if len(a.Files) != len(b.Files) {
+			return false
+		}
+		for k, v := range a.Files {
+			bv, ok := b.Files[k]
+			if !ok || !c.equal(v, bv) {
+				return false
+			}
+		}
+
+		return true
+	case *ParenExpr:
+		b, ok := b.(*ParenExpr)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Lparen
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Lparen, b.Decs.Lparen) {
+			return false
+		}
+
+		// Node: X
+		if !c.equal(a.X, b.X) {
+			return false
+		}
+
+		// Decoration: X
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.X, b.Decs.X) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *RangeStmt:
+		b, ok := b.(*RangeStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: For
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.For, b.Decs.For) {
+			return false
+		}
+
+		// Node: Key
+		if !c.equal(a.Key, b.Key) {
+			return false
+		}
+
+		// Decoration: Key
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Key, b.Decs.Key) {
+			return false
+		}
+
+		// Node: Value
+		if !c.equal(a.Value, b.Value) {
+			return false
+		}
+
+		// Decoration: Value
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Value, b.Decs.Value) {
+			return false
+		}
+
+		// Token: Tok
+		if a.Tok != b.Tok {
+			return false
+		}
+
+		// Decoration: Range
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Range, b.Decs.Range) {
+			return false
+		}
+
+		// Node: X
+		if !c.equal(a.X, b.X) {
+			return false
+		}
+
+		// Decoration: X
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.X, b.Decs.X) {
+			return false
+		}
+
+		// Node: Body
+		if !c.equal(a.Body, b.Body) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *ReturnStmt:
+		b, ok := b.(*ReturnStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Return
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Return, b.Decs.Return) {
+			return false
+		}
+
+		// List: Results
+		if len(a.Results) != len(b.Results) {
+			return false
+		}
+		for i, v := range a.Results {
+			if !c.equal(v, b.Results[i]) {
+				return false
+			}
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *SelectStmt:
+		b, ok := b.(*SelectStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Select
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Select, b.Decs.Select) {
+			return false
+		}
+
+		// Node: Body
+		if !c.equal(a.Body, b.Body) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *SelectorExpr:
+		b, ok := b.(*SelectorExpr)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: X
+		if !c.equal(a.X, b.X) {
+			return false
+		}
+
+		// Decoration: X
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.X, b.Decs.X) {
+			return false
+		}
+
+		// Node: Sel
+		if !c.equal(a.Sel, b.Sel) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *SendStmt:
+		b, ok := b.(*SendStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: Chan
+		if !c.equal(a.Chan, b.Chan) {
+			return false
+		}
+
+		// Decoration: Chan
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Chan, b.Decs.Chan) {
+			return false
+		}
+
+		// Decoration: Arrow
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Arrow, b.Decs.Arrow) {
+			return false
+		}
+
+		// Node: Value
+		if !c.equal(a.Value, b.Value) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *SliceExpr:
+		b, ok := b.(*SliceExpr)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: X
+		if !c.equal(a.X, b.X) {
+			return false
+		}
+
+		// Decoration: X
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.X, b.Decs.X) {
+			return false
+		}
+
+		// Decoration: Lbrack
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Lbrack, b.Decs.Lbrack) {
+			return false
+		}
+
+		// Node: Low
+		if !c.equal(a.Low, b.Low) {
+			return false
+		}
+
+		// Decoration: Low
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Low, b.Decs.Low) {
+			return false
+		}
+
+		// Node: High
+		if !c.equal(a.High, b.High) {
+			return false
+		}
+
+		// Decoration: High
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.High, b.Decs.High) {
+			return false
+		}
+
+		// Node: Max
+		if !c.equal(a.Max, b.Max) {
+			return false
+		}
+
+		// Decoration: Max
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Max, b.Decs.Max) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		// Value: Slice3
+		if a.Slice3 != b.Slice3 {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *StarExpr:
+		b, ok := b.(*StarExpr)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Star
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Star, b.Decs.Star) {
+			return false
+		}
+
+		// Node: X
+		if !c.equal(a.X, b.X) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *StructType:
+		b, ok := b.(*StructType)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Struct
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Struct, b.Decs.Struct) {
+			return false
+		}
+
+		// Node: Fields
+		if !c.equal(a.Fields, b.Fields) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		// Value: Incomplete
+		if a.Incomplete != b.Incomplete {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *SwitchStmt:
+		b, ok := b.(*SwitchStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Switch
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Switch, b.Decs.Switch) {
+			return false
+		}
+
+		// Node: Init
+		if !c.equal(a.Init, b.Init) {
+			return false
+		}
+
+		// Decoration: Init
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Init, b.Decs.Init) {
+			return false
+		}
+
+		// Node: Tag
+		if !c.equal(a.Tag, b.Tag) {
+			return false
+		}
+
+		// Decoration: Tag
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Tag, b.Decs.Tag) {
+			return false
+		}
+
+		// Node: Body
+		if !c.equal(a.Body, b.Body) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *TypeAssertExpr:
+		b, ok := b.(*TypeAssertExpr)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: X
+		if !c.equal(a.X, b.X) {
+			return false
+		}
+
+		// Decoration: X
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.X, b.Decs.X) {
+			return false
+		}
+
+		// Decoration: Lparen
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Lparen, b.Decs.Lparen) {
+			return false
+		}
+
+		// Node: Type
+		if !c.equal(a.Type, b.Type) {
+			return false
+		}
+
+		// Decoration: Type
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Type, b.Decs.Type) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *TypeSpec:
+		b, ok := b.(*TypeSpec)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Node: Name
+		if !c.equal(a.Name, b.Name) {
+			return false
+		}
+
+		// Token: Assign
+		if a.Assign != b.Assign {
+			return false
+		}
+
+		// Decoration: Name
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Name, b.Decs.Name) {
+			return false
+		}
+
+		// Node: TypeParams
+		if !c.equal(a.TypeParams, b.TypeParams) {
+			return false
+		}
+
+		// Decoration: TypeParams
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.TypeParams, b.Decs.TypeParams) {
+			return false
+		}
+
+		// Node: Type
+		if !c.equal(a.Type, b.Type) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *TypeSwitchStmt:
+		b, ok := b.(*TypeSwitchStmt)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Decoration: Switch
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Switch, b.Decs.Switch) {
+			return false
+		}
+
+		// Node: Init
+		if !c.equal(a.Init, b.Init) {
+			return false
+		}
+
+		// Decoration: Init
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Init, b.Decs.Init) {
+			return false
+		}
+
+		// Node: Assign
+		if !c.equal(a.Assign, b.Assign) {
+			return false
+		}
+
+		// Decoration: Assign
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Assign, b.Decs.Assign) {
+			return false
+		}
+
+		// Node: Body
+		if !c.equal(a.Body, b.Body) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *UnaryExpr:
+		b, ok := b.(*UnaryExpr)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// Token: Op
+		if a.Op != b.Op {
+			return false
+		}
+
+		// Decoration: Op
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Op, b.Decs.Op) {
+			return false
+		}
+
+		// Node: X
+		if !c.equal(a.X, b.X) {
+			return false
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	case *ValueSpec:
+		b, ok := b.(*ValueSpec)
+		if !ok {
+			return false
+		}
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		if !c.ignoreSpacing && a.Decs.Before != b.Decs.Before {
+			return false
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Start, b.Decs.Start) {
+			return false
+		}
+
+		// List: Names
+		if len(a.Names) != len(b.Names) {
+			return false
+		}
+		for i, v := range a.Names {
+			if !c.equal(v, b.Names[i]) {
+				return false
+			}
+		}
+
+		// Node: Type
+		if !c.equal(a.Type, b.Type) {
+			return false
+		}
+
+		// Decoration: Assign
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.Assign, b.Decs.Assign) {
+			return false
+		}
+
+		// List: Values
+		if len(a.Values) != len(b.Values) {
+			return false
+		}
+		for i, v := range a.Values {
+			if !c.equal(v, b.Values[i]) {
+				return false
+			}
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations && !equalDecorations(a.Decs.End, b.Decs.End) {
+			return false
+		}
+
+		if !c.ignoreSpacing && a.Decs.After != b.Decs.After {
+			return false
+		}
+
+		return true
+	default:
+		panic(fmt.Sprintf("%T", a))
+	}
+}
+
+// hash writes the fields of n that are compared by equal to h.
+func (c *equalConfig) hash(h io.Writer, n Node) {
+	if n == nil {
+		hashValue(h, nil)
+		return
+	}
+	n = c.localIdent(n)
+	switch n := n.(type) {
+	case *ArrayType:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "ArrayType")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Lbrack
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Lbrack)
+		}
+
+		// Node: Len
+		c.hash(h, n.Len)
+
+		// Decoration: Len
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Len)
+		}
+
+		// Node: Elt
+		c.hash(h, n.Elt)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *AssignStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "AssignStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// List: Lhs
+		hashValue(h, len(n.Lhs))
+		for _, v := range n.Lhs {
+			c.hash(h, v)
+		}
+
+		// Token: Tok
+		hashValue(h, n.Tok)
+
+		// Decoration: Tok
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Tok)
+		}
+
+		// List: Rhs
+		hashValue(h, len(n.Rhs))
+		for _, v := range n.Rhs {
+			c.hash(h, v)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *BadDecl:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "BadDecl")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Bad
+		hashValue(h, n.Length)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *BadExpr:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "BadExpr")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Bad
+		hashValue(h, n.Length)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *BadStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "BadStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Bad
+		hashValue(h, n.Length)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *BasicLit:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "BasicLit")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// String: Value
+		hashValue(h, n.Value)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		// Value: Kind
+		hashValue(h, n.Kind)
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *BinaryExpr:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "BinaryExpr")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: X
+		c.hash(h, n.X)
+
+		// Decoration: X
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.X)
+		}
+
+		// Token: Op
+		hashValue(h, n.Op)
+
+		// Decoration: Op
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Op)
+		}
+
+		// Node: Y
+		c.hash(h, n.Y)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *BlockStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "BlockStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Lbrace
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Lbrace)
+		}
+
+		// List: List
+		hashValue(h, len(n.List))
+		for _, v := range n.List {
+			c.hash(h, v)
+		}
+
+		// Token: Rbrace
+		hashValue(h, n.RbraceHasNoPos)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *BranchStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "BranchStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Token: Tok
+		hashValue(h, n.Tok)
+
+		// Decoration: Tok
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Tok)
+		}
+
+		// Node: Label
+		c.hash(h, n.Label)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *CallExpr:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "CallExpr")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: Fun
+		c.hash(h, n.Fun)
+
+		// Decoration: Fun
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Fun)
+		}
+
+		// Decoration: Lparen
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Lparen)
+		}
+
+		// List: Args
+		hashValue(h, len(n.Args))
+		for _, v := range n.Args {
+			c.hash(h, v)
+		}
+
+		// Token: Ellipsis
+		hashValue(h, n.Ellipsis)
+
+		// Decoration: Ellipsis
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Ellipsis)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *CaseClause:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "CaseClause")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Case
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Case)
+		}
+
+		// List: List
+		hashValue(h, len(n.List))
+		for _, v := range n.List {
+			c.hash(h, v)
+		}
+
+		// Decoration: Colon
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Colon)
+		}
+
+		// List: Body
+		hashValue(h, len(n.Body))
+		for _, v := range n.Body {
+			c.hash(h, v)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *ChanType:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "ChanType")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Begin
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Begin)
+		}
+
+		// Decoration: Arrow
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Arrow)
+		}
+
+		// Node: Value
+		c.hash(h, n.Value)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		// Value: Dir
+		hashValue(h, n.Dir)
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *CommClause:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "CommClause")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Case
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Case)
+		}
+
+		// Node: Comm
+		c.hash(h, n.Comm)
+
+		// Decoration: Comm
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Comm)
+		}
+
+		// Decoration: Colon
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Colon)
+		}
+
+		// List: Body
+		hashValue(h, len(n.Body))
+		for _, v := range n.Body {
+			c.hash(h, v)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *CommentDecl:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "CommentDecl")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *CommentStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "CommentStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *CompositeLit:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "CompositeLit")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: Type
+		c.hash(h, n.Type)
+
+		// Decoration: Type
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Type)
+		}
+
+		// Decoration: Lbrace
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Lbrace)
+		}
+
+		// List: Elts
+		hashValue(h, len(n.Elts))
+		for _, v := range n.Elts {
+			c.hash(h, v)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		// Value: Incomplete
+		hashValue(h, n.Incomplete)
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *DeclStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "DeclStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: Decl
+		c.hash(h, n.Decl)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *DeferStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "DeferStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Defer
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Defer)
+		}
+
+		// Node: Call
+		c.hash(h, n.Call)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *Ellipsis:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "Ellipsis")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Ellipsis
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Ellipsis)
+		}
+
+		// Node: Elt
+		c.hash(h, n.Elt)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *EmptyStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "EmptyStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		// Value: Implicit
+		hashValue(h, n.Implicit)
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *ExprStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "ExprStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: X
+		c.hash(h, n.X)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *Field:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "Field")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// List: Names
+		hashValue(h, len(n.Names))
+		for _, v := range n.Names {
+			c.hash(h, v)
+		}
+
+		// Node: Type
+		c.hash(h, n.Type)
+
+		// Decoration: Type
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Type)
+		}
+
+		// Node: Tag
+		c.hash(h, n.Tag)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *FieldList:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "FieldList")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Token: Opening
+		hashValue(h, n.Opening)
+
+		// Decoration: Opening
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Opening)
+		}
+
+		// List: List
+		hashValue(h, len(n.List))
+		for _, v := range n.List {
+			c.hash(h, v)
+		}
+
+		// Token: Closing
+		hashValue(h, n.Closing)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *File:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "File")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Package
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Package)
+		}
+
+		// Node: Name
+		c.hash(h, n.Name)
+
+		// Decoration: Name
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Name)
+		}
+
+		// List: Decls
+		hashValue(h, len(n.Decls))
+		for _, v := range n.Decls {
+			c.hash(h, v)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		// List: Imports
+		hashValue(h, len(n.Imports))
+		for _, v := range n.Imports {
+			c.hash(h, v)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *ForStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "ForStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: For
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.For)
+		}
+
+		// Node: Init
+		c.hash(h, n.Init)
+
+		// Decoration: Init
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Init)
+		}
+
+		// Node: Cond
+		c.hash(h, n.Cond)
+
+		// Decoration: Cond
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Cond)
+		}
+
+		// Node: Post
+		c.hash(h, n.Post)
+
+		// Decoration: Post
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Post)
+		}
+
+		// Node: Body
+		c.hash(h, n.Body)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *FuncDecl:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "FuncDecl")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Init: Type
+		c.hash(h, n.Type)
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Func
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Func)
+		}
+
+		// Node: Recv
+		c.hash(h, n.Recv)
+
+		// Decoration: Recv
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Recv)
+		}
+
+		// Node: Name
+		c.hash(h, n.Name)
+
+		// Decoration: Name
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Name)
+		}
+
+		// Decoration: TypeParams
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.TypeParams)
+		}
+
+		// Decoration: Params
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Params)
+		}
+
+		// Decoration: Results
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Results)
+		}
+
+		// Node: Body
+		c.hash(h, n.Body)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *FuncLit:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "FuncLit")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: Type
+		c.hash(h, n.Type)
+
+		// Decoration: Type
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Type)
+		}
+
+		// Node: Body
+		c.hash(h, n.Body)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *FuncType:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "FuncType")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Token: Func
+		hashValue(h, n.Func)
+
+		// Decoration: Func
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Func)
+		}
+
+		// Node: TypeParams
+		c.hash(h, n.TypeParams)
+
+		// Decoration: TypeParams
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.TypeParams)
+		}
+
+		// Node: Params
+		c.hash(h, n.Params)
+
+		// Decoration: Params
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Params)
+		}
+
+		// Node: Results
+		c.hash(h, n.Results)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *GenDecl:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "GenDecl")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Token: Tok
+		hashValue(h, n.Tok)
+
+		// Decoration: Tok
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Tok)
+		}
+
+		// Token: Lparen
+		hashValue(h, n.Lparen)
+
+		// Decoration: Lparen
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Lparen)
+		}
+
+		// List: Specs
+		hashValue(h, len(n.Specs))
+		for _, v := range n.Specs {
+			c.hash(h, v)
+		}
+
+		// Token: Rparen
+		hashValue(h, n.Rparen)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *GoStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "GoStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Go
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Go)
+		}
+
+		// Node: Call
+		c.hash(h, n.Call)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *Ident:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "Ident")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: X
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.X)
+		}
+
+		// String: Name
+		hashValue(h, n.Name)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		// Path: Path
+		if !c.ignorePath {
+			hashValue(h, n.Path)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *IfStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "IfStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: If
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.If)
+		}
+
+		// Node: Init
+		c.hash(h, n.Init)
+
+		// Decoration: Init
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Init)
+		}
+
+		// Node: Cond
+		c.hash(h, n.Cond)
+
+		// Decoration: Cond
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Cond)
+		}
+
+		// Node: Body
+		c.hash(h, n.Body)
+
+		// Decoration: Else
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Else)
+		}
+
+		// Node: Else
+		c.hash(h, n.Else)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *ImportSpec:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "ImportSpec")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: Name
+		c.hash(h, n.Name)
+
+		// Decoration: Name
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Name)
+		}
+
+		// Node: Path
+		c.hash(h, n.Path)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *IncDecStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "IncDecStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: X
+		c.hash(h, n.X)
+
+		// Decoration: X
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.X)
+		}
+
+		// Token: Tok
+		hashValue(h, n.Tok)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *IndexExpr:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "IndexExpr")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: X
+		c.hash(h, n.X)
+
+		// Decoration: X
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.X)
+		}
+
+		// Decoration: Lbrack
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Lbrack)
+		}
+
+		// Node: Index
+		c.hash(h, n.Index)
+
+		// Decoration: Index
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Index)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *IndexListExpr:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "IndexListExpr")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: X
+		c.hash(h, n.X)
+
+		// Decoration: X
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.X)
+		}
+
+		// Decoration: Lbrack
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Lbrack)
+		}
+
+		// List: Indices
+		hashValue(h, len(n.Indices))
+		for _, v := range n.Indices {
+			c.hash(h, v)
+		}
+
+		// Decoration: Indices
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Indices)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *InterfaceType:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "InterfaceType")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Interface
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Interface)
+		}
+
+		// Node: Methods
+		c.hash(h, n.Methods)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		// Value: Incomplete
+		hashValue(h, n.Incomplete)
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *KeyValueExpr:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "KeyValueExpr")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: Key
+		c.hash(h, n.Key)
+
+		// Decoration: Key
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Key)
+		}
+
+		// Decoration: Colon
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Colon)
+		}
+
+		// Node: Value
+		c.hash(h, n.Value)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *LabeledStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "LabeledStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: Label
+		c.hash(h, n.Label)
+
+		// Decoration: Label
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Label)
+		}
+
+		// Decoration: Colon
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Colon)
+		}
+
+		// Node: Stmt
+		c.hash(h, n.Stmt)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *MapType:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "MapType")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Map
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Map)
+		}
+
+		// Node: Key
+		c.hash(h, n.Key)
+
+		// Decoration: Key
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Key)
+		}
+
+		// Node: Value
+		c.hash(h, n.Value)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *Package:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "Package")
+
+		// Value: Name
+		hashValue(h, n.Name)
+
+		// Map: Files
+		var keys []string
+		for k := range n.Files {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			hashValue(h, k)
+			c.hash(h, n.Files[k])
+		}
+	case *ParenExpr:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "ParenExpr")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Lparen
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Lparen)
+		}
+
+		// Node: X
+		c.hash(h, n.X)
+
+		// Decoration: X
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.X)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *RangeStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "RangeStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: For
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.For)
+		}
+
+		// Node: Key
+		c.hash(h, n.Key)
+
+		// Decoration: Key
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Key)
+		}
+
+		// Node: Value
+		c.hash(h, n.Value)
+
+		// Decoration: Value
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Value)
+		}
+
+		// Token: Tok
+		hashValue(h, n.Tok)
+
+		// Decoration: Range
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Range)
+		}
+
+		// Node: X
+		c.hash(h, n.X)
+
+		// Decoration: X
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.X)
+		}
+
+		// Node: Body
+		c.hash(h, n.Body)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *ReturnStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "ReturnStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Return
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Return)
+		}
+
+		// List: Results
+		hashValue(h, len(n.Results))
+		for _, v := range n.Results {
+			c.hash(h, v)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *SelectStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "SelectStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Select
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Select)
+		}
+
+		// Node: Body
+		c.hash(h, n.Body)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *SelectorExpr:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "SelectorExpr")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: X
+		c.hash(h, n.X)
+
+		// Decoration: X
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.X)
+		}
+
+		// Node: Sel
+		c.hash(h, n.Sel)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *SendStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "SendStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: Chan
+		c.hash(h, n.Chan)
+
+		// Decoration: Chan
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Chan)
+		}
+
+		// Decoration: Arrow
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Arrow)
+		}
+
+		// Node: Value
+		c.hash(h, n.Value)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *SliceExpr:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "SliceExpr")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: X
+		c.hash(h, n.X)
+
+		// Decoration: X
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.X)
+		}
+
+		// Decoration: Lbrack
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Lbrack)
+		}
+
+		// Node: Low
+		c.hash(h, n.Low)
+
+		// Decoration: Low
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Low)
+		}
+
+		// Node: High
+		c.hash(h, n.High)
+
+		// Decoration: High
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.High)
+		}
+
+		// Node: Max
+		c.hash(h, n.Max)
+
+		// Decoration: Max
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Max)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		// Value: Slice3
+		hashValue(h, n.Slice3)
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *StarExpr:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "StarExpr")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Star
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Star)
+		}
+
+		// Node: X
+		c.hash(h, n.X)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *StructType:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "StructType")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Struct
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Struct)
+		}
+
+		// Node: Fields
+		c.hash(h, n.Fields)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		// Value: Incomplete
+		hashValue(h, n.Incomplete)
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *SwitchStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "SwitchStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Switch
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Switch)
+		}
+
+		// Node: Init
+		c.hash(h, n.Init)
+
+		// Decoration: Init
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Init)
+		}
+
+		// Node: Tag
+		c.hash(h, n.Tag)
+
+		// Decoration: Tag
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Tag)
+		}
+
+		// Node: Body
+		c.hash(h, n.Body)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *TypeAssertExpr:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "TypeAssertExpr")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: X
+		c.hash(h, n.X)
+
+		// Decoration: X
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.X)
+		}
+
+		// Decoration: Lparen
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Lparen)
+		}
+
+		// Node: Type
+		c.hash(h, n.Type)
+
+		// Decoration: Type
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Type)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *TypeSpec:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "TypeSpec")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Node: Name
+		c.hash(h, n.Name)
+
+		// Token: Assign
+		hashValue(h, n.Assign)
+
+		// Decoration: Name
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Name)
+		}
+
+		// Node: TypeParams
+		c.hash(h, n.TypeParams)
+
+		// Decoration: TypeParams
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.TypeParams)
+		}
+
+		// Node: Type
+		c.hash(h, n.Type)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *TypeSwitchStmt:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "TypeSwitchStmt")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Decoration: Switch
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Switch)
+		}
+
+		// Node: Init
+		c.hash(h, n.Init)
+
+		// Decoration: Init
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Init)
+		}
+
+		// Node: Assign
+		c.hash(h, n.Assign)
+
+		// Decoration: Assign
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Assign)
+		}
+
+		// Node: Body
+		c.hash(h, n.Body)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *UnaryExpr:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "UnaryExpr")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// Token: Op
+		hashValue(h, n.Op)
+
+		// Decoration: Op
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Op)
+		}
+
+		// Node: X
+		c.hash(h, n.X)
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	case *ValueSpec:
+		if n == nil {
+			hashValue(h, nil)
+			return
+		}
+		hashValue(h, "ValueSpec")
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.Before)
+		}
+
+		// Decoration: Start
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Start)
+		}
+
+		// List: Names
+		hashValue(h, len(n.Names))
+		for _, v := range n.Names {
+			c.hash(h, v)
+		}
+
+		// Node: Type
+		c.hash(h, n.Type)
+
+		// Decoration: Assign
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.Assign)
+		}
+
+		// List: Values
+		hashValue(h, len(n.Values))
+		for _, v := range n.Values {
+			c.hash(h, v)
+		}
+
+		// Decoration: End
+		if !c.ignoreDecorations {
+			hashValue(h, n.Decs.End)
+		}
+
+		if !c.ignoreSpacing {
+			hashValue(h, n.Decs.After)
+		}
+	default:
+		panic(fmt.Sprintf("%T", n))
+	}
+}
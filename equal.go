@@ -0,0 +1,141 @@
+package dst
+
+import (
+	"fmt"
+	"hash/fnv"
+	"io"
+)
+
+// EqualOption is an option for Equal and Hash.
+type EqualOption func(*equalConfig)
+
+// IgnoreDecorations configures Equal and Hash to ignore the decorations (comments and newlines)
+// attached to nodes. The Before and After line spacing is still compared unless IgnoreSpacing is
+// also used.
+func IgnoreDecorations() EqualOption {
+	return func(c *equalConfig) {
+		c.ignoreDecorations = true
+	}
+}
+
+// IgnoreSpacing configures Equal and Hash to ignore the Before and After line spacing of nodes.
+func IgnoreSpacing() EqualOption {
+	return func(c *equalConfig) {
+		c.ignoreSpacing = true
+	}
+}
+
+// IgnorePath configures Equal and Hash to compare qualified identifiers with their local form: an
+// Ident with Path set is equal to an Ident with the same name and no Path, and to a SelectorExpr
+// with the same name qualified by an unqualified identifier (e.g. "fmt.Println" in code decorated
+// without a resolver). Identifiers with different non-empty Paths are still different. Both are
+// equal to the local form, so they have the same Hash.
+func IgnorePath() EqualOption {
+	return func(c *equalConfig) {
+		c.ignorePath = true
+	}
+}
+
+type equalConfig struct {
+	ignoreDecorations bool
+	ignoreSpacing     bool
+	ignorePath        bool
+}
+
+func newEqualConfig(opts []EqualOption) *equalConfig {
+	c := &equalConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Equal reports whether a and b represent the same code. By default all fields and decorations
+// are compared. Objects and scopes are ignored.
+func Equal(a, b Node, opts ...EqualOption) bool {
+	return newEqualConfig(opts).equal(a, b)
+}
+
+// Hash returns a hash of n that is consistent with Equal: if Equal(a, b, opts...) is true then
+// Hash(a, opts...) == Hash(b, opts...).
+func Hash(n Node, opts ...EqualOption) uint64 {
+	h := fnv.New64a()
+	newEqualConfig(opts).hash(h, n)
+	return h.Sum64()
+}
+
+// equalQualified compares an Ident with Path set and a SelectorExpr when IgnorePath is used. The
+// restorer prints a qualified identifier as a selector with the decorations of the identifier, so
+// the selector must have the same decorations and none on its package name or Sel. ok is false if
+// a and b aren't an Ident and a SelectorExpr.
+func (c *equalConfig) equalQualified(a, b Node) (equal, ok bool) {
+	if !c.ignorePath {
+		return false, false
+	}
+	id, ok := a.(*Ident)
+	if !ok {
+		id, ok = b.(*Ident)
+		a, b = b, a
+	}
+	sel, isSel := b.(*SelectorExpr)
+	if !ok || !isSel || id == nil || sel == nil {
+		return false, false
+	}
+	x, ok := sel.X.(*Ident)
+	if id.Path == "" || !ok || x.Path != "" || sel.Sel == nil || sel.Sel.Name != id.Name {
+		return false, true
+	}
+	if !c.equal(x, &Ident{Name: x.Name}) || !c.equal(sel.Sel, &Ident{Name: sel.Sel.Name}) {
+		return false, true
+	}
+	return c.equal(id, selectorIdent(sel)), true
+}
+
+// localIdent returns the unqualified Ident that is equal to n when IgnorePath is used if n is a
+// SelectorExpr that may be a qualified identifier, or n otherwise. Hash uses it so a selector has
+// the same hash as the qualified identifier.
+func (c *equalConfig) localIdent(n Node) Node {
+	sel, ok := n.(*SelectorExpr)
+	if !c.ignorePath || !ok || sel == nil || sel.Sel == nil {
+		return n
+	}
+	if x, ok := sel.X.(*Ident); !ok || x.Path != "" {
+		return n
+	}
+	return selectorIdent(sel)
+}
+
+// selectorIdent returns an Ident with the name and decorations of sel.
+func selectorIdent(sel *SelectorExpr) *Ident {
+	return &Ident{Name: sel.Sel.Name, Decs: IdentDecorations{NodeDecs: sel.Decs.NodeDecs, X: sel.Decs.X}}
+}
+
+func equalDecorations(a, b Decorations) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// hashValue writes a value to h. Strings and decorations are prefixed by their length so adjacent
+// values can't run together.
+func hashValue(h io.Writer, v interface{}) {
+	switch v := v.(type) {
+	case nil:
+		fmt.Fprint(h, "nil;")
+	case string:
+		fmt.Fprintf(h, "%d:%s;", len(v), v)
+	case Decorations:
+		fmt.Fprintf(h, "%d:", len(v))
+		for _, d := range v {
+			hashValue(h, d)
+		}
+	default:
+		fmt.Fprintf(h, "%T:%v;", v, v)
+	}
+}
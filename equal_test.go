@@ -0,0 +1,151 @@
+package dst_test
+
+import (
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+	"github.com/dave/dst/decorator/resolver/goast"
+)
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		opts     []dst.EqualOption
+		expect   bool
+		sameHash bool
+	}{
+		{
+			name:   "same",
+			a:      "package a\n\n// a\nfunc a(b int) {\n\tc(b) // c\n}\n",
+			b:      "package a\n\n// a\nfunc a(b int) {\n\tc(b) // c\n}\n",
+			expect: true,
+		},
+		{
+			name:   "different-code",
+			a:      "package a\n\nfunc a(b int) {\n\tc(b)\n}\n",
+			b:      "package a\n\nfunc a(b int) {\n\tc(b, b)\n}\n",
+			expect: false,
+		},
+		{
+			name:   "different-token",
+			a:      "package a\n\nvar a = b + c\n",
+			b:      "package a\n\nvar a = b - c\n",
+			expect: false,
+		},
+		{
+			name:   "different-func-type",
+			a:      "package a\n\nfunc a(b int) {}\n",
+			b:      "package a\n\nfunc a(b string) {}\n",
+			expect: false,
+		},
+		{
+			name:   "comments",
+			a:      "package a\n\n// a\nfunc a() {\n\tc() // c\n}\n",
+			b:      "package a\n\nfunc a() {\n\tc()\n}\n",
+			expect: false,
+		},
+		{
+			name:   "comments-ignored",
+			a:      "package a\n\n// a\nfunc a() {\n\tc() // c\n}\n",
+			b:      "package a\n\nfunc a() {\n\tc()\n}\n",
+			opts:   []dst.EqualOption{dst.IgnoreDecorations()},
+			expect: true,
+		},
+		{
+			name:   "spacing",
+			a:      "package a\n\nfunc a() {\n\tb()\n\n\tc()\n}\n",
+			b:      "package a\n\nfunc a() {\n\tb()\n\tc()\n}\n",
+			expect: false,
+		},
+		{
+			name:   "spacing-ignored",
+			a:      "package a\n\nfunc a() {\n\tb()\n\n\tc()\n}\n",
+			b:      "package a\n\nfunc a() {\n\tb()\n\tc()\n}\n",
+			opts:   []dst.EqualOption{dst.IgnoreSpacing()},
+			expect: true,
+		},
+		{
+			name:   "path",
+			a:      "package a\n\nimport \"fmt\"\n\nvar a = fmt.Sprint\n",
+			b:      "package a\n\nvar a = Sprint\n",
+			expect: false,
+		},
+		{
+			name:   "path-ignored",
+			a:      "package a\n\nimport \"fmt\"\n\nvar a = fmt.Sprint\n",
+			b:      "package a\n\nvar a = Sprint\n",
+			opts:   []dst.EqualOption{dst.IgnorePath()},
+			expect: true,
+		},
+		{
+			name:   "path-selector",
+			a:      "package a\n\nimport \"fmt\"\n\nvar a = fmt.Sprint\n",
+			b:      "package a\n\nvar a = fmt.Sprint\n",
+			expect: false,
+		},
+		{
+			name:   "path-selector-ignored",
+			a:      "package a\n\nimport \"fmt\"\n\nvar a = fmt.Sprint\n",
+			b:      "package a\n\nvar a = fmt.Sprint\n",
+			opts:   []dst.EqualOption{dst.IgnorePath()},
+			expect: true,
+		},
+		{
+			name:   "path-selector-different-name",
+			a:      "package a\n\nimport \"fmt\"\n\nvar a = fmt.Sprint\n",
+			b:      "package a\n\nvar a = fmt.Sprintf\n",
+			opts:   []dst.EqualOption{dst.IgnorePath()},
+			expect: false,
+		},
+		{
+			name:     "different-paths-ignored",
+			a:        "package a\n\nimport \"a/fmt\"\n\nvar a = fmt.Sprint\n",
+			b:        "package a\n\nimport \"b/fmt\"\n\nvar a = fmt.Sprint\n",
+			opts:     []dst.EqualOption{dst.IgnorePath()},
+			expect:   false,
+			sameHash: true, // both are equal to the local identifier
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parse := func(code string) dst.Node {
+				d := decorator.NewDecoratorWithImports(nil, "a", goast.New())
+				f, err := d.Parse(code)
+				if err != nil {
+					t.Fatal(err)
+				}
+				// compare the last declaration so the import blocks are not compared
+				return f.Decls[len(f.Decls)-1]
+			}
+			a, b := parse(test.a), parse(test.b)
+			if found := dst.Equal(a, b, test.opts...); found != test.expect {
+				t.Errorf("expected Equal to return %v, found %v", test.expect, found)
+			}
+			if test.expect && dst.Hash(a, test.opts...) != dst.Hash(b, test.opts...) {
+				t.Error("expected equal hashes")
+			}
+			if !test.expect && !test.sameHash && dst.Hash(a, test.opts...) == dst.Hash(b, test.opts...) {
+				t.Error("expected different hashes")
+			}
+		})
+	}
+}
+
+func TestEqualClone(t *testing.T) {
+	f, err := decorator.Parse("package a\n\n// a\nfunc a(b int) (c string) {\n\tfor _, v := range b {\n\t\td(v) // d\n\t}\n\treturn\n}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := dst.Clone(f)
+	if !dst.Equal(f, c) {
+		t.Error("expected clone to be equal")
+	}
+	if dst.Hash(f) != dst.Hash(c) {
+		t.Error("expected clone to have an equal hash")
+	}
+	if !dst.Equal(nil, nil) || dst.Equal(f, nil) || dst.Equal(f.Decls[0], f) {
+		t.Error("unexpected result comparing nil or different node types")
+	}
+}
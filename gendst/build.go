@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dave/dst/gendst/data"
+	. "github.com/dave/jennifer/jen"
+)
+
+// notest
+
+func generateBuild(names []string) error {
+
+	f := NewFilePathName(DSTPATH+"/build", "build")
+	f.ImportName(DSTPATH, "dst")
+
+	for _, nodeName := range names {
+
+		if nodeName == "Package" {
+			// Package has no decorations
+			continue
+		}
+
+		// type <name> struct {
+		// 	Node *dst.<name>
+		// }
+		f.Commentf("%s holds a *dst.%s with chainable methods to set decorations. Use Build to get the", nodeName, nodeName)
+		f.Commentf("*dst.%s.", nodeName)
+		f.Type().Id(nodeName).Struct(
+			Id("Node").Op("*").Qual(DSTPATH, nodeName),
+		)
+		f.Line()
+
+		f.Commentf("Build returns the *dst.%s.", nodeName)
+		f.Func().Params(Id("b").Op("*").Id(nodeName)).Id("Build").Params().Op("*").Qual(DSTPATH, nodeName).Block(
+			Return(Id("b").Dot("Node")),
+		)
+		f.Line()
+
+		for _, kind := range []struct {
+			method, iface string
+			is            map[string]bool
+		}{
+			{"BuildExpr", "Expr", data.Exprs},
+			{"BuildStmt", "Stmt", data.Stmts},
+		} {
+			if !kind.is[nodeName] {
+				continue
+			}
+			f.Commentf("%s returns the *dst.%s. It implements %sBuilder.", kind.method, nodeName, kind.iface)
+			f.Func().Params(Id("b").Op("*").Id(nodeName)).Id(kind.method).Params().Qual(DSTPATH, kind.iface).Block(
+				Return(Id("b").Dot("Node")),
+			)
+			f.Line()
+		}
+
+		f.Comment("WithComment appends comments to the Start decorations. Comments should include the \"//\" or")
+		f.Comment("\"/*\" markers.")
+		f.Func().Params(Id("b").Op("*").Id(nodeName)).Id("WithComment").Params(Id("comments").Op("...").String()).Op("*").Id(nodeName).Block(
+			Id("b").Dot("Node").Dot("Decs").Dot("Start").Dot("Append").Call(Id("comments").Op("...")),
+			Return(Id("b")),
+		)
+		f.Line()
+
+		f.Comment("WithEndComment appends comments to the End decorations. Comments should include the \"//\" or")
+		f.Comment("\"/*\" markers.")
+		f.Func().Params(Id("b").Op("*").Id(nodeName)).Id("WithEndComment").Params(Id("comments").Op("...").String()).Op("*").Id(nodeName).Block(
+			Id("b").Dot("Node").Dot("Decs").Dot("End").Dot("Append").Call(Id("comments").Op("...")),
+			Return(Id("b")),
+		)
+		f.Line()
+
+		for _, space := range []struct{ name, field, value string }{
+			{"NewLineBefore", "Before", "NewLine"},
+			{"EmptyLineBefore", "Before", "EmptyLine"},
+			{"NewLineAfter", "After", "NewLine"},
+			{"EmptyLineAfter", "After", "EmptyLine"},
+		} {
+			f.Commentf("%s sets the %s space to dst.%s.", space.name, space.field, space.value)
+			f.Func().Params(Id("b").Op("*").Id(nodeName)).Id(space.name).Params().Op("*").Id(nodeName).Block(
+				Id("b").Dot("Node").Dot("Decs").Dot(space.field).Op("=").Qual(DSTPATH, space.value),
+				Return(Id("b")),
+			)
+			f.Line()
+		}
+
+		for _, frag := range data.Info[nodeName] {
+			switch frag := frag.(type) {
+			case data.Decoration:
+				if frag.Name == "Start" || frag.Name == "End" {
+					continue
+				}
+				name := fmt.Sprintf("Decorate%s", frag.Name)
+				f.Commentf("%s appends decorations to the %s decorations.", name, frag.Name)
+				f.Func().Params(Id("b").Op("*").Id(nodeName)).Id(name).Params(Id("decs").Op("...").String()).Op("*").Id(nodeName).Block(
+					Id("b").Dot("Node").Dot("Decs").Dot(frag.Name).Dot("Append").Call(Id("decs").Op("...")),
+					Return(Id("b")),
+				)
+				f.Line()
+			}
+		}
+	}
+
+	return f.Save("./build/builders-generated.go")
+}
@@ -8,10 +8,14 @@ import (
 
 // notest
 
+// DSTPATH is the import path of the dst package.
 const DSTPATH = "github.com/dave/dst"
 
+// Part is a fragment of a node: one of Init, Decoration, PathDecoration, SpecialDecoration,
+// String, List, Map, Node, Token, Bad, Value, Scope or Object.
 type Part interface{}
 
+// Info maps the name of each node type to its parts, in the order they are rendered in the source.
 var Info = map[string][]Part{
 	/*
 		// A Field represents a Field declaration list in a struct type,
@@ -1165,6 +1169,19 @@ var Info = map[string][]Part{
 			Field: Field{"Implicit"},
 		},
 	},
+	/*
+		// A CommentStmt node represents a free-floating comment in a statement list. It has no
+		// go/ast equivalent: the comments are in the Start decorations.
+		CommentStmt struct{}
+	*/
+	"CommentStmt": {
+		Decoration{
+			Name: "Start",
+		},
+		Decoration{
+			Name: "End",
+		},
+	},
 	/*
 		// A LabeledStmt node represents a labeled statement.
 		LabeledStmt struct {
@@ -2072,6 +2089,19 @@ var Info = map[string][]Part{
 			Name: "End",
 		},
 	},
+	/*
+		// A CommentDecl node represents a free-floating comment in the declarations of a file. It
+		// has no go/ast equivalent: the comments are in the Start decorations.
+		CommentDecl struct{}
+	*/
+	"CommentDecl": {
+		Decoration{
+			Name: "Start",
+		},
+		Decoration{
+			Name: "End",
+		},
+	},
 	/*
 		// A GenDecl node (generic declaration node) represents an import,
 		// constant, type or variable declaration. A valid Lparen position
@@ -2364,6 +2394,7 @@ var Info = map[string][]Part{
 	},
 }
 
+// Exprs is the set of node types that implement dst.Expr.
 var Exprs = map[string]bool{
 	"BadExpr":        true,
 	"Ident":          true,
@@ -2389,8 +2420,10 @@ var Exprs = map[string]bool{
 	"ChanType":       true,
 }
 
+// Stmts is the set of node types that implement dst.Stmt.
 var Stmts = map[string]bool{
 	"BadStmt":        true,
+	"CommentStmt":    true,
 	"DeclStmt":       true,
 	"EmptyStmt":      true,
 	"LabeledStmt":    true,
@@ -2413,41 +2446,60 @@ var Stmts = map[string]bool{
 	"RangeStmt":      true,
 }
 
+// Decls is the set of node types that implement dst.Decl.
 var Decls = map[string]bool{
-	"BadDecl":  true,
-	"GenDecl":  true,
-	"FuncDecl": true,
+	"BadDecl":     true,
+	"CommentDecl": true,
+	"GenDecl":     true,
+	"FuncDecl":    true,
+}
+
+// Synthetic is the set of node types that have no go/ast equivalent. They are created by the
+// decorator from free-floating comments, and the restorer adds their decorations to the output
+// without creating a node.
+var Synthetic = map[string]bool{
+	"CommentStmt": true,
+	"CommentDecl": true,
 }
 
+// Specs is the set of node types that implement dst.Spec.
 var Specs = map[string]bool{
 	"ImportSpec": true,
 	"ValueSpec":  true,
 	"TypeSpec":   true,
 }
 
+// Init is a field that must be initialised before the other parts are set (e.g. the Type of a
+// FuncDecl, which holds some of the fields of the FuncDecl).
 type Init struct {
 	Name  string
 	Field FieldSpec
 	Type  TypeSpec
 }
 
+// Decoration is a decoration attachment point. The decorations are stored in the Decs field of
+// the node in a field with the same name.
 type Decoration struct {
 	Name    string
 	Use     Code
 	Disable bool // disable this in the fragger / decorator (equivalent to Use = false)
 }
 
+// PathDecoration is the import path of a qualified identifier (Ident.Path).
 type PathDecoration struct {
 	Name  string
 	Field FieldSpec
 }
 
+// SpecialDecoration renders decorations from a field other than Decs (e.g. the decorations of
+// FuncDecl.Type are rendered by the FuncDecl).
 type SpecialDecoration struct {
 	Name string
 	Decs FieldSpec
 	End  bool // Is this an "End" decoration (e.g. triggers end-of-node logic in applyDecorations)?
 }
 
+// String is a string value that is rendered to the output (e.g. Ident.Name or BasicLit.Value).
 type String struct {
 	Name          string
 	ValueField    FieldSpec
@@ -2455,6 +2507,7 @@ type String struct {
 	Literal       bool // if Literal == true, we apply possible newlines inside the string if it's multi-line
 }
 
+// List is a field containing a slice of nodes.
 type List struct {
 	Name      string
 	Field     FieldSpec
@@ -2463,18 +2516,22 @@ type List struct {
 	NoRestore bool
 }
 
+// Map is a field containing a map of nodes (e.g. Package.Files).
 type Map struct {
 	Name  string
 	Field FieldSpec
 	Elem  TypeSpec
 }
 
+// Node is a field containing a single child node.
 type Node struct {
 	Name  string
 	Field FieldSpec
 	Type  TypeSpec
 }
 
+// Token is a token rendered to the output. ExistsField is the bool field that records whether
+// an optional token exists, and TokenField is the field that holds the token value if it varies.
 type Token struct {
 	Name          string
 	Exists        Code
@@ -2485,33 +2542,40 @@ type Token struct {
 	NoPosField    FieldSpec
 }
 
+// Bad is the length of a BadExpr, BadStmt or BadDecl.
 type Bad struct {
 	Length             Code
 	LengthField        FieldSpec
 	FromField, ToField FieldSpec
 }
 
-// Value that must be copied from ast.Node to dst.Node but doesn't result in anything rendered to the output.
+// Value is a value that must be copied from ast.Node to dst.Node but doesn't result in anything
+// rendered to the output.
 type Value struct {
 	Name  string
 	Field FieldSpec
 	Value Code
 }
 
+// Scope is a field containing a *dst.Scope.
 type Scope struct {
 	Name  string
 	Field FieldSpec
 }
 
+// Object is a field containing a *dst.Object.
 type Object struct {
 	Name  string
 	Field FieldSpec
 }
 
+// Code generates an expression for use in generated code. The id is the name of the variable
+// holding the node, and ast is true when generating code that operates on ast nodes.
 type Code interface {
 	Get(id string, ast bool) *jen.Statement
 }
 
+// Basic is Code that is independent of the node.
 type Basic struct {
 	*jen.Statement
 }
@@ -2520,12 +2584,14 @@ func (b Basic) Get(id string, ast bool) *jen.Statement {
 	return b.Statement
 }
 
+// Expr is Code that is generated from the variable holding the node.
 type Expr func(n *jen.Statement) *jen.Statement
 
 func (e Expr) Get(id string, ast bool) *jen.Statement {
 	return e(jen.Id(id))
 }
 
+// Double is Code that is different for ast and dst nodes.
 type Double struct {
 	Ast Expr
 	Dst Expr
@@ -2538,11 +2604,13 @@ func (d Double) Get(id string, ast bool) *jen.Statement {
 	return d.Dst(jen.Id(id))
 }
 
+// TypeSpec is the type of a node field: either Iface or Struct.
 type TypeSpec interface {
 	TypeName() string
 	Literal(path string) *jen.Statement
 }
 
+// Iface is an interface type (e.g. Expr).
 type Iface struct {
 	Name string
 }
@@ -2555,6 +2623,7 @@ func (i Iface) TypeName() string {
 	return i.Name
 }
 
+// Struct is a pointer to a struct type (e.g. *Ident).
 type Struct struct {
 	Name string
 }
@@ -2567,11 +2636,13 @@ func (s Struct) TypeName() string {
 	return s.Name
 }
 
+// FieldSpec is the location of a field: either Field or InnerField.
 type FieldSpec interface {
 	Get(id string) *jen.Statement
 	FieldName() string
 }
 
+// Field is a field of the node.
 type Field struct {
 	Name string
 }
@@ -2584,6 +2655,7 @@ func (f Field) FieldName() string {
 	return f.Name
 }
 
+// InnerField is a field of a field of the node (e.g. FuncDecl.Type.Params).
 type InnerField struct {
 	Inner, Name string
 }
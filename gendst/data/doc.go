@@ -0,0 +1,20 @@
+// Package data describes the dst node types. Info lists the parts of each node type in the order
+// they are rendered in the source: child nodes and lists, tokens, strings, values and decoration
+// attachment points. The code generators in gendst use this metadata to create the node-specific
+// portions of the dst, decorator and dstutil packages.
+//
+// Third party generators can use the same metadata to create code that stays in sync with the
+// dst node types. See the generator package for running them.
+package data
+
+import "sort"
+
+// Names returns the names of all node types in alphabetical order.
+func Names() []string {
+	var names []string
+	for name := range Info {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
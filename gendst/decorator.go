@@ -31,6 +31,10 @@ func generateDecorator(names []string) error {
 		)
 		g.Switch(Id("n").Op(":=").Id("n").Assert(Id("type"))).BlockFunc(func(g *Group) {
 			for _, nodeName := range names {
+				if data.Synthetic[nodeName] {
+					// added by insertCommentNodes after the tree is decorated
+					continue
+				}
 				g.Case(Op("*").Qual("go/ast", nodeName)).BlockFunc(func(g *Group) {
 
 					switch nodeName {
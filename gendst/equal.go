@@ -0,0 +1,219 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dave/dst/gendst/data"
+	. "github.com/dave/jennifer/jen"
+)
+
+// notest
+
+func generateEqual(names []string) error {
+
+	f := NewFilePathName(DSTPATH, "dst")
+
+	f.Comment("equal reports whether a and b represent the same code.")
+	f.Func().Params(Id("c").Op("*").Id("equalConfig")).Id("equal").Params(List(Id("a"), Id("b")).Id("Node")).Bool().BlockFunc(func(g *Group) {
+		g.If(Id("a").Op("==").Nil().Op("||").Id("b").Op("==").Nil()).Block(
+			Return(Id("a").Op("==").Id("b")),
+		)
+		g.If(List(Id("equal"), Id("ok")).Op(":=").Id("c").Dot("equalQualified").Call(Id("a"), Id("b")), Id("ok")).Block(
+			Return(Id("equal")),
+		)
+		g.Switch(Id("a").Op(":=").Id("a").Assert(Id("type"))).BlockFunc(func(g *Group) {
+			for _, nodeName := range names {
+				g.Case(Op("*").Id(nodeName)).BlockFunc(func(g *Group) {
+					g.List(Id("b"), Id("ok")).Op(":=").Id("b").Assert(Op("*").Id(nodeName))
+					g.If(Op("!").Id("ok")).Block(Return(False()))
+					g.If(Id("a").Op("==").Nil().Op("||").Id("b").Op("==").Nil()).Block(
+						Return(Id("a").Op("==").Id("b")),
+					)
+
+					if nodeName != "Package" {
+						g.Line()
+						g.If(Op("!").Id("c").Dot("ignoreSpacing").Op("&&").Id("a").Dot("Decs").Dot("Before").Op("!=").Id("b").Dot("Decs").Dot("Before")).Block(Return(False()))
+					}
+
+					for _, frag := range data.Info[nodeName] {
+						switch frag := frag.(type) {
+						case data.Init:
+							// FuncDecl.Type is compared as a whole, so the inner fields are skipped below.
+							g.Line().Commentf("Init: %s", frag.Name)
+							g.If(Op("!").Id("c").Dot("equal").Call(frag.Field.Get("a"), frag.Field.Get("b"))).Block(Return(False()))
+						case data.Decoration:
+							g.Line().Commentf("Decoration: %s", frag.Name)
+							g.If(Op("!").Id("c").Dot("ignoreDecorations").Op("&&").Op("!").Id("equalDecorations").Call(Id("a").Dot("Decs").Dot(frag.Name), Id("b").Dot("Decs").Dot(frag.Name))).Block(Return(False()))
+						case data.Token:
+							for _, field := range []data.FieldSpec{frag.NoPosField, frag.TokenField, frag.ExistsField} {
+								if field == nil || isInner(field) {
+									continue
+								}
+								g.Line().Commentf("Token: %s", frag.Name)
+								g.If(field.Get("a").Op("!=").Add(field.Get("b"))).Block(Return(False()))
+							}
+						case data.String:
+							g.Line().Commentf("String: %s", frag.Name)
+							g.If(frag.ValueField.Get("a").Op("!=").Add(frag.ValueField.Get("b"))).Block(Return(False()))
+						case data.Node:
+							if isInner(frag.Field) {
+								continue
+							}
+							g.Line().Commentf("Node: %s", frag.Name)
+							g.If(Op("!").Id("c").Dot("equal").Call(frag.Field.Get("a"), frag.Field.Get("b"))).Block(Return(False()))
+						case data.List:
+							g.Line().Commentf("List: %s", frag.Name)
+							g.If(Len(frag.Field.Get("a")).Op("!=").Len(frag.Field.Get("b"))).Block(Return(False()))
+							g.For(List(Id("i"), Id("v")).Op(":=").Range().Add(frag.Field.Get("a"))).Block(
+								If(Op("!").Id("c").Dot("equal").Call(Id("v"), frag.Field.Get("b").Index(Id("i")))).Block(Return(False())),
+							)
+						case data.Map:
+							if frag.Elem.TypeName() == "Object" {
+								// Objects are ignored
+								continue
+							}
+							g.Line().Commentf("Map: %s", frag.Name)
+							g.If(Len(frag.Field.Get("a")).Op("!=").Len(frag.Field.Get("b"))).Block(Return(False()))
+							g.For(List(Id("k"), Id("v")).Op(":=").Range().Add(frag.Field.Get("a"))).Block(
+								List(Id("bv"), Id("ok")).Op(":=").Add(frag.Field.Get("b")).Index(Id("k")),
+								If(Op("!").Id("ok").Op("||").Op("!").Id("c").Dot("equal").Call(Id("v"), Id("bv"))).Block(Return(False())),
+							)
+						case data.Value:
+							g.Line().Commentf("Value: %s", frag.Name)
+							g.If(frag.Field.Get("a").Op("!=").Add(frag.Field.Get("b"))).Block(Return(False()))
+						case data.Bad:
+							g.Line().Comment("Bad")
+							g.If(frag.LengthField.Get("a").Op("!=").Add(frag.LengthField.Get("b"))).Block(Return(False()))
+						case data.PathDecoration:
+							g.Line().Commentf("Path: %s", frag.Name)
+							// with IgnorePath, a qualified identifier is equal to a local one, but not to
+							// one with a different Path
+							g.If(frag.Field.Get("a").Op("!=").Add(frag.Field.Get("b")).Op("&&").Parens(
+								Op("!").Id("c").Dot("ignorePath").Op("||").Add(frag.Field.Get("a")).Op("!=").Lit("").Op("&&").Add(frag.Field.Get("b")).Op("!=").Lit(""),
+							)).Block(Return(False()))
+						case data.Scope, data.Object, data.SpecialDecoration:
+							// ignore
+						default:
+							panic(fmt.Sprintf("unknown fragment type %T", frag))
+						}
+					}
+
+					if nodeName != "Package" {
+						g.Line()
+						g.If(Op("!").Id("c").Dot("ignoreSpacing").Op("&&").Id("a").Dot("Decs").Dot("After").Op("!=").Id("b").Dot("Decs").Dot("After")).Block(Return(False()))
+					}
+
+					g.Line()
+					g.Return(True())
+				})
+			}
+			g.Default().Block(
+				Panic(Qual("fmt", "Sprintf").Call(Lit("%T"), Id("a"))),
+			)
+		})
+	})
+
+	f.Comment("hash writes the fields of n that are compared by equal to h.")
+	f.Func().Params(Id("c").Op("*").Id("equalConfig")).Id("hash").Params(Id("h").Qual("io", "Writer"), Id("n").Id("Node")).BlockFunc(func(g *Group) {
+		g.If(Id("n").Op("==").Nil()).Block(
+			Id("hashValue").Call(Id("h"), Nil()),
+			Return(),
+		)
+		g.Id("n").Op("=").Id("c").Dot("localIdent").Call(Id("n"))
+		g.Switch(Id("n").Op(":=").Id("n").Assert(Id("type"))).BlockFunc(func(g *Group) {
+			for _, nodeName := range names {
+				g.Case(Op("*").Id(nodeName)).BlockFunc(func(g *Group) {
+					g.If(Id("n").Op("==").Nil()).Block(
+						Id("hashValue").Call(Id("h"), Nil()),
+						Return(),
+					)
+					g.Id("hashValue").Call(Id("h"), Lit(nodeName))
+
+					if nodeName != "Package" {
+						g.Line()
+						g.If(Op("!").Id("c").Dot("ignoreSpacing")).Block(
+							Id("hashValue").Call(Id("h"), Id("n").Dot("Decs").Dot("Before")),
+						)
+					}
+
+					for _, frag := range data.Info[nodeName] {
+						switch frag := frag.(type) {
+						case data.Init:
+							g.Line().Commentf("Init: %s", frag.Name)
+							g.Id("c").Dot("hash").Call(Id("h"), frag.Field.Get("n"))
+						case data.Decoration:
+							g.Line().Commentf("Decoration: %s", frag.Name)
+							g.If(Op("!").Id("c").Dot("ignoreDecorations")).Block(
+								Id("hashValue").Call(Id("h"), Id("n").Dot("Decs").Dot(frag.Name)),
+							)
+						case data.Token:
+							for _, field := range []data.FieldSpec{frag.NoPosField, frag.TokenField, frag.ExistsField} {
+								if field == nil || isInner(field) {
+									continue
+								}
+								g.Line().Commentf("Token: %s", frag.Name)
+								g.Id("hashValue").Call(Id("h"), field.Get("n"))
+							}
+						case data.String:
+							g.Line().Commentf("String: %s", frag.Name)
+							g.Id("hashValue").Call(Id("h"), frag.ValueField.Get("n"))
+						case data.Node:
+							if isInner(frag.Field) {
+								continue
+							}
+							g.Line().Commentf("Node: %s", frag.Name)
+							g.Id("c").Dot("hash").Call(Id("h"), frag.Field.Get("n"))
+						case data.List:
+							g.Line().Commentf("List: %s", frag.Name)
+							g.Id("hashValue").Call(Id("h"), Len(frag.Field.Get("n")))
+							g.For(List(Id("_"), Id("v")).Op(":=").Range().Add(frag.Field.Get("n"))).Block(
+								Id("c").Dot("hash").Call(Id("h"), Id("v")),
+							)
+						case data.Map:
+							if frag.Elem.TypeName() == "Object" {
+								continue
+							}
+							g.Line().Commentf("Map: %s", frag.Name)
+							g.Var().Id("keys").Index().String()
+							g.For(Id("k").Op(":=").Range().Add(frag.Field.Get("n"))).Block(
+								Id("keys").Op("=").Append(Id("keys"), Id("k")),
+							)
+							g.Qual("sort", "Strings").Call(Id("keys"))
+							g.For(List(Id("_"), Id("k")).Op(":=").Range().Id("keys")).Block(
+								Id("hashValue").Call(Id("h"), Id("k")),
+								Id("c").Dot("hash").Call(Id("h"), frag.Field.Get("n").Index(Id("k"))),
+							)
+						case data.Value:
+							g.Line().Commentf("Value: %s", frag.Name)
+							g.Id("hashValue").Call(Id("h"), frag.Field.Get("n"))
+						case data.Bad:
+							g.Line().Comment("Bad")
+							g.Id("hashValue").Call(Id("h"), frag.LengthField.Get("n"))
+						case data.PathDecoration:
+							g.Line().Commentf("Path: %s", frag.Name)
+							g.If(Op("!").Id("c").Dot("ignorePath")).Block(
+								Id("hashValue").Call(Id("h"), frag.Field.Get("n")),
+							)
+						case data.Scope, data.Object, data.SpecialDecoration:
+							// ignore
+						default:
+							panic(fmt.Sprintf("unknown fragment type %T", frag))
+						}
+					}
+
+					if nodeName != "Package" {
+						g.Line()
+						g.If(Op("!").Id("c").Dot("ignoreSpacing")).Block(
+							Id("hashValue").Call(Id("h"), Id("n").Dot("Decs").Dot("After")),
+						)
+					}
+				})
+			}
+			g.Default().Block(
+				Panic(Qual("fmt", "Sprintf").Call(Lit("%T"), Id("n"))),
+			)
+		})
+	})
+
+	return f.Save("./equal-generated.go")
+}
@@ -17,6 +17,9 @@ func generateFragger(names []string) error {
 		),
 		Switch(Id("n").Op(":=").Id("n").Assert(Type())).BlockFunc(func(g *Group) {
 			for _, nodeName := range names {
+				if data.Synthetic[nodeName] {
+					continue
+				}
 				g.Case(Op("*").Qual("go/ast", nodeName)).BlockFunc(func(g *Group) {
 					for _, frag := range data.Info[nodeName] {
 						switch frag := frag.(type) {
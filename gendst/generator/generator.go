@@ -0,0 +1,90 @@
+// Package generator runs code generators over the node metadata in the data package. The gendst
+// command registers the generators for the dst, decorator, dstutil and build packages. Third
+// parties can register their own generators and run them from their own command:
+//
+//	func main() {
+//		generator.Register("visitor", generateVisitor)
+//		if err := generator.Run(); err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+//
+//	func generateVisitor(names []string) error {
+//		for _, name := range names {
+//			for _, part := range data.Info[name] {
+//				...
+//			}
+//		}
+//		return nil
+//	}
+package generator
+
+import (
+	"fmt"
+
+	"github.com/dave/dst/gendst/data"
+)
+
+// Func generates code. Names are the names of all node types in alphabetical order. The metadata
+// for each node type is in data.Info.
+type Func func(names []string) error
+
+type generator struct {
+	name string
+	f    Func
+}
+
+var generators []generator
+
+// Register adds a generator. Generators are run in the order they were registered. Register
+// panics if a generator with the same name has already been registered.
+func Register(name string, f Func) {
+	for _, g := range generators {
+		if g.name == name {
+			panic(fmt.Sprintf("generator %s already registered", name))
+		}
+	}
+	generators = append(generators, generator{name: name, f: f})
+}
+
+// Registered returns the names of the registered generators in the order they were registered.
+func Registered() []string {
+	var names []string
+	for _, g := range generators {
+		names = append(names, g.name)
+	}
+	return names
+}
+
+// Run runs the registered generators. If any names are provided, only the generators with those
+// names are run.
+func Run(names ...string) error {
+	only := map[string]bool{}
+	for _, name := range names {
+		only[name] = true
+	}
+	for _, name := range names {
+		if !registered(name) {
+			return fmt.Errorf("generator %s not registered", name)
+		}
+	}
+	nodes := data.Names()
+	for _, g := range generators {
+		if len(only) > 0 && !only[g.name] {
+			continue
+		}
+		if err := g.f(nodes); err != nil {
+			return fmt.Errorf("generator %s: %w", g.name, err)
+		}
+	}
+	return nil
+}
+
+func registered(name string) bool {
+	for _, g := range generators {
+		if g.name == name {
+			return true
+		}
+	}
+	return false
+}
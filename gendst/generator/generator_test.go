@@ -0,0 +1,58 @@
+package generator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	defer func(saved []generator) { generators = saved }(generators)
+	generators = nil
+
+	var ran []string
+	for _, name := range []string{"a", "b", "c"} {
+		name := name
+		Register(name, func(names []string) error {
+			if len(names) == 0 || names[0] != "ArrayType" {
+				t.Errorf("unexpected names %v", names)
+			}
+			ran = append(ran, name)
+			return nil
+		})
+	}
+	if found := Registered(); !reflect.DeepEqual(found, []string{"a", "b", "c"}) {
+		t.Errorf("unexpected registered generators %v", found)
+	}
+
+	if err := Run(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ran, []string{"a", "b", "c"}) {
+		t.Errorf("unexpected generators run %v", ran)
+	}
+
+	ran = nil
+	if err := Run("c", "a"); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ran, []string{"a", "c"}) {
+		t.Errorf("unexpected generators run %v", ran)
+	}
+
+	if err := Run("d"); err == nil || err.Error() != "generator d not registered" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	defer func(saved []generator) { generators = saved }(generators)
+	generators = nil
+
+	Register("a", func([]string) error { return nil })
+	defer func() {
+		if r := recover(); r != "generator a already registered" {
+			t.Errorf("unexpected panic %v", r)
+		}
+	}()
+	Register("a", func([]string) error { return nil })
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dave/dst/gendst/data"
+	. "github.com/dave/jennifer/jen"
+)
+
+// notest
+
+func generateGoString(names []string) error {
+
+	f := NewFile("dstutil")
+	f.ImportName(DSTPATH, "dst")
+	// func (p *goPrinter) node(n dst.Node) {
+	// 	if p.nilNode(n) {
+	// 		return
+	// 	}
+	// 	switch n := n.(type) {
+	// 	case <type>:
+	// 		...
+	// 	default:
+	// 		panic(...)
+	// 	}
+	// }
+	f.Func().Params(Id("p").Op("*").Id("goPrinter")).Id("node").Params(Id("n").Qual(DSTPATH, "Node")).BlockFunc(func(g *Group) {
+		g.If(Id("p").Dot("nilNode").Call(Id("n"))).Block(Return())
+		g.Switch(Id("n").Op(":=").Id("n").Assert(Id("type"))).BlockFunc(func(g *Group) {
+			for _, nodeName := range names {
+				g.Case(Op("*").Qual(DSTPATH, nodeName)).BlockFunc(func(g *Group) {
+					g.Id("p").Dot("open").Call(Lit(fmt.Sprintf("&dst.%s{", nodeName)))
+					// FuncDecl.Type is printed as a complete FuncType where the first InnerField
+					// node is found, so the other InnerField fragments are ignored.
+					var init *data.Init
+					for _, frag := range data.Info[nodeName] {
+						switch frag := frag.(type) {
+						case data.Init:
+							init = &frag
+						case data.Token:
+							if frag.ExistsField != nil && !isInner(frag.ExistsField) {
+								g.Line().Commentf("Token: %s", frag.Name)
+								g.Id("p").Dot("value").Call(Lit(frag.ExistsField.FieldName()), frag.ExistsField.Get("n"))
+							}
+							if frag.TokenField != nil {
+								g.Line().Commentf("Token: %s", frag.Name)
+								g.Id("p").Dot("value").Call(Lit(frag.TokenField.FieldName()), frag.TokenField.Get("n"))
+							}
+							if frag.NoPosField != nil {
+								g.Line().Commentf("Token: %s", frag.Name)
+								g.Id("p").Dot("value").Call(Lit(frag.NoPosField.FieldName()), frag.NoPosField.Get("n"))
+							}
+						case data.String:
+							g.Line().Commentf("String: %s", frag.Name)
+							g.Id("p").Dot("value").Call(Lit(frag.ValueField.FieldName()), frag.ValueField.Get("n"))
+						case data.Node:
+							if isInner(frag.Field) {
+								if init != nil {
+									g.Line().Commentf("Init: %s", init.Name)
+									g.If(init.Field.Get("n").Op("!=").Nil()).Block(
+										Id("p").Dot("key").Call(Lit(init.Field.FieldName())),
+										Id("p").Dot("node").Call(init.Field.Get("n")),
+									)
+									init = nil
+								}
+								continue
+							}
+							g.Line().Commentf("Node: %s", frag.Name)
+							g.If(frag.Field.Get("n").Op("!=").Nil()).Block(
+								Id("p").Dot("key").Call(Lit(frag.Field.FieldName())),
+								Id("p").Dot("node").Call(frag.Field.Get("n")),
+							)
+						case data.List:
+							if frag.NoRestore {
+								continue
+							}
+							g.Line().Commentf("List: %s", frag.Name)
+							g.If(Len(frag.Field.Get("n")).Op(">").Lit(0)).BlockFunc(func(g *Group) {
+								g.Id("p").Dot("key").Call(Lit(frag.Field.FieldName()))
+								g.Id("p").Dot("open").Call(Lit(fmt.Sprintf("[]%s{", elemLiteral(frag.Elem))))
+								_, elide := frag.Elem.(data.Struct)
+								g.For(List(Id("_"), Id("v")).Op(":=").Range().Add(frag.Field.Get("n"))).Block(
+									Id("p").Dot("elem").Call(Lit(elide)),
+									Id("p").Dot("node").Call(Id("v")),
+								)
+								g.Id("p").Dot("close").Call()
+							})
+						case data.Map:
+							if frag.Elem.TypeName() == "Object" {
+								continue
+							}
+							g.Line().Commentf("Map: %s", frag.Name)
+							g.If(Len(frag.Field.Get("n")).Op(">").Lit(0)).BlockFunc(func(g *Group) {
+								g.Id("p").Dot("key").Call(Lit(frag.Field.FieldName()))
+								g.Id("p").Dot("open").Call(Lit(fmt.Sprintf("map[string]%s{", elemLiteral(frag.Elem))))
+								g.For(List(Id("_"), Id("k")).Op(":=").Range().Id("sortedKeys").Call(frag.Field.Get("n"))).Block(
+									Id("p").Dot("mapKey").Call(Id("k")),
+									Id("p").Dot("node").Call(frag.Field.Get("n").Index(Id("k"))),
+								)
+								g.Id("p").Dot("close").Call()
+							})
+						case data.Value:
+							g.Line().Commentf("Value: %s", frag.Name)
+							g.Id("p").Dot("value").Call(Lit(frag.Field.FieldName()), frag.Field.Get("n"))
+						case data.Bad:
+							g.Line().Comment("Bad")
+							g.Id("p").Dot("value").Call(Lit(frag.LengthField.FieldName()), frag.LengthField.Get("n"))
+						case data.PathDecoration:
+							g.Line().Commentf("Path: %s", frag.Name)
+							g.Id("p").Dot("value").Call(Lit(frag.Field.FieldName()), frag.Field.Get("n"))
+						case data.Decoration, data.SpecialDecoration, data.Scope, data.Object:
+							// decorations are printed by p.decs, scopes and objects are ignored
+						default:
+							panic(fmt.Sprintf("unknown fragment type %T", frag))
+						}
+					}
+					if nodeName != "Package" {
+						g.Line()
+						g.Id("p").Dot("decs").Call(Id("n"))
+					}
+					g.Id("p").Dot("close").Call()
+				})
+			}
+			g.Default().Block(
+				Panic(Qual("fmt", "Sprintf").Call(Lit("dstutil: GoString doesn't support node type %T"), Id("n"))),
+			)
+		})
+	})
+
+	return f.Save("./dstutil/gostring-generated.go")
+}
+
+func isInner(f data.FieldSpec) bool {
+	_, ok := f.(data.InnerField)
+	return ok
+}
+
+func elemLiteral(t data.TypeSpec) string {
+	if _, ok := t.(data.Struct); ok {
+		return "*dst." + t.TypeName()
+	}
+	return "dst." + t.TypeName()
+}
@@ -1,46 +1,35 @@
 package main
 
 import (
-	"sort"
+	"os"
 
-	"github.com/dave/dst/gendst/data"
+	"github.com/dave/dst/gendst/generator"
 )
 
 // notest
 
+func init() {
+	generator.Register("dst", generateDst)
+	generator.Register("decs", generateDstDecs)
+	generator.Register("fragger", generateFragger)
+	generator.Register("decorator", generateDecorator)
+	generator.Register("decorator-test-helper", generateDecoratorTestHelper)
+	generator.Register("restorer", generateRestorer)
+	generator.Register("clone", generateClone)
+	generator.Register("gostring", generateGoString)
+	generator.Register("build", generateBuild)
+	generator.Register("equal", generateEqual)
+	generator.Register("meta", generateMeta)
+	generator.Register("validate", generateValidate)
+}
+
 func main() {
 	if err := run(); err != nil {
 		panic(err)
 	}
 }
 
+// run runs all the generators, or only those named in the command line arguments.
 func run() error {
-	var names []string
-	for name := range data.Info {
-		names = append(names, name)
-	}
-	sort.Strings(names)
-
-	if err := generateDst(names); err != nil {
-		return err
-	}
-	if err := generateDstDecs(names); err != nil {
-		return err
-	}
-	if err := generateFragger(names); err != nil {
-		return err
-	}
-	if err := generateDecorator(names); err != nil {
-		return err
-	}
-	if err := generateDecoratorTestHelper(names); err != nil {
-		return err
-	}
-	if err := generateRestorer(names); err != nil {
-		return err
-	}
-	if err := generateClone(names); err != nil {
-		return err
-	}
-	return nil
+	return generator.Run(os.Args[1:]...)
 }
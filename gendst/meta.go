@@ -0,0 +1,228 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dave/dst/gendst/data"
+	. "github.com/dave/jennifer/jen"
+)
+
+// notest
+
+func generateMeta(names []string) error {
+
+	f := NewFilePathName(DSTPATH+"/meta", "meta")
+	f.ImportName(DSTPATH, "dst")
+
+	// var nodes = []*Node{...}
+	f.Var().Id("nodes").Op("=").Index().Op("*").Id("Node").ValuesFunc(func(g *Group) {
+		for _, nodeName := range names {
+			g.Line().Op("&").Id("Node").Values(DictFunc(func(d Dict) {
+				d[Id("Name")] = Lit(nodeName)
+				for _, category := range []struct {
+					name string
+					set  map[string]bool
+				}{{"Expr", data.Exprs}, {"Stmt", data.Stmts}, {"Decl", data.Decls}, {"Spec", data.Specs}} {
+					if category.set[nodeName] {
+						d[Id("Category")] = Lit(category.name)
+					}
+				}
+				d[Id("New")] = Func().Params().Qual(DSTPATH, "Node").Block(
+					Return(Op("&").Qual(DSTPATH, nodeName).Values()),
+				)
+				d[Id("Fields")] = Index().Id("Field").ValuesFunc(func(g *Group) {
+					for _, field := range metaFields(nodeName) {
+						g.Line().Add(field)
+					}
+					g.Line()
+				})
+				var decorations []Code
+				for _, frag := range data.Info[nodeName] {
+					if frag, ok := frag.(data.Decoration); ok {
+						decorations = append(decorations, Lit(frag.Name))
+					}
+				}
+				if len(decorations) > 0 {
+					d[Id("Decorations")] = Index().String().Values(decorations...)
+				}
+			}))
+		}
+		g.Line()
+	})
+
+	// func Of(n dst.Node) *Node
+	f.Comment("Of returns the metadata for the type of n, or nil if n is not a dst node.")
+	f.Func().Id("Of").Params(Id("n").Qual(DSTPATH, "Node")).Op("*").Id("Node").Block(
+		Switch(Id("n").Assert(Id("type"))).BlockFunc(func(g *Group) {
+			for i, nodeName := range names {
+				g.Case(Op("*").Qual(DSTPATH, nodeName)).Block(
+					Return(Id("nodes").Index(Lit(i))),
+				)
+			}
+		}),
+		Return(Nil()),
+	)
+
+	return f.Save("./meta/meta-generated.go")
+}
+
+// metaFields returns the Field literals for a node type in the order they appear in the source.
+func metaFields(nodeName string) []Code {
+	var out []Code
+	done := map[string]bool{}
+	node := func(n *Statement) *Statement {
+		return n.Assert(Op("*").Qual(DSTPATH, nodeName))
+	}
+	var derived bool
+	add := func(name string, kind string, typ string, iface bool, get, set Code) {
+		if done[name] {
+			return
+		}
+		done[name] = true
+		out = append(out, Values(DictFunc(func(d Dict) {
+			d[Id("Name")] = Lit(name)
+			d[Id("Kind")] = Id(kind)
+			d[Id("Type")] = Lit(typ)
+			if iface {
+				d[Id("Interface")] = True()
+			}
+			if derived {
+				d[Id("Derived")] = True()
+			}
+			d[Id("Get")] = Func().Params(Id("n").Qual(DSTPATH, "Node")).Interface().Add(get)
+			d[Id("Set")] = Func().Params(Id("n").Qual(DSTPATH, "Node"), Id("v").Interface()).Add(set)
+		})))
+	}
+	// simple values: Get returns the field, Set asserts the type
+	simple := func(field data.FieldSpec, typ *Statement, typeName, kind string) {
+		if _, ok := field.(data.InnerField); ok {
+			return
+		}
+		name := field.FieldName()
+		add(name, kind, typeName, false,
+			Block(Return(node(Id("n")).Dot(name))),
+			Block(node(Id("n")).Dot(name).Op("=").Id("v").Assert(typ)),
+		)
+	}
+
+	for _, frag := range data.Info[nodeName] {
+		switch frag := frag.(type) {
+		case data.Init:
+			// FuncDecl.Type is described as a single child node where its inner fields are rendered.
+		case data.Node:
+			field, typ := frag.Field, frag.Type
+			if _, ok := field.(data.InnerField); ok {
+				init := initField(nodeName)
+				if init == nil {
+					continue
+				}
+				field, typ = init.Field, init.Type
+			}
+			name := field.FieldName()
+			_, iface := typ.(data.Iface)
+			add(name, "NodeField", typ.TypeName(), iface,
+				Block(
+					If(Id("v").Op(":=").Add(node(Id("n"))).Dot(name), Id("v").Op("!=").Nil()).Block(Return(Id("v"))),
+					Return(Nil()),
+				),
+				Block(
+					List(Id("x"), Id("_")).Op(":=").Id("v").Assert(typ.Literal(DSTPATH)),
+					node(Id("n")).Dot(name).Op("=").Id("x"),
+				),
+			)
+		case data.List:
+			name := frag.Field.FieldName()
+			_, iface := frag.Elem.(data.Iface)
+			// File.Imports duplicates the import specs in File.Decls
+			derived = frag.NoRestore
+			add(name, "ListField", frag.Elem.TypeName(), iface,
+				Block(
+					Var().Id("out").Index().Qual(DSTPATH, "Node"),
+					For(List(Id("_"), Id("e")).Op(":=").Range().Add(node(Id("n"))).Dot(name)).Block(
+						If(Id("e").Op("==").Nil()).Block(
+							Id("out").Op("=").Append(Id("out"), Nil()),
+							Continue(),
+						),
+						Id("out").Op("=").Append(Id("out"), Id("e")),
+					),
+					Return(Id("out")),
+				),
+				Block(
+					Var().Id("out").Index().Add(frag.Elem.Literal(DSTPATH)),
+					For(List(Id("_"), Id("e")).Op(":=").Range().Id("v").Assert(Index().Qual(DSTPATH, "Node"))).Block(
+						// nil elements (as returned by Get) become typed nil elements
+						If(Id("e").Op("==").Nil()).Block(
+							Id("out").Op("=").Append(Id("out"), Nil()),
+							Continue(),
+						),
+						Id("out").Op("=").Append(Id("out"), Id("e").Assert(frag.Elem.Literal(DSTPATH))),
+					),
+					node(Id("n")).Dot(name).Op("=").Id("out"),
+				),
+			)
+		case data.Map:
+			if frag.Elem.TypeName() == "Object" {
+				continue
+			}
+			name := frag.Field.FieldName()
+			add(name, "MapField", frag.Elem.TypeName(), false,
+				Block(
+					Id("out").Op(":=").Map(String()).Qual(DSTPATH, "Node").Values(),
+					For(List(Id("k"), Id("e")).Op(":=").Range().Add(node(Id("n"))).Dot(name)).Block(
+						Id("out").Index(Id("k")).Op("=").Id("e"),
+					),
+					Return(Id("out")),
+				),
+				Block(
+					Id("out").Op(":=").Map(String()).Add(frag.Elem.Literal(DSTPATH)).Values(),
+					For(List(Id("k"), Id("e")).Op(":=").Range().Id("v").Assert(Map(String()).Qual(DSTPATH, "Node"))).Block(
+						Id("out").Index(Id("k")).Op("=").Id("e").Assert(frag.Elem.Literal(DSTPATH)),
+					),
+					node(Id("n")).Dot(name).Op("=").Id("out"),
+				),
+			)
+		case data.Token:
+			if frag.TokenField != nil {
+				simple(frag.TokenField, Qual("go/token", "Token"), "token.Token", "TokenField")
+			}
+			if frag.ExistsField != nil {
+				simple(frag.ExistsField, Bool(), "bool", "TokenField")
+			}
+			if frag.NoPosField != nil {
+				simple(frag.NoPosField, Bool(), "bool", "TokenField")
+			}
+		case data.String:
+			simple(frag.ValueField, String(), "string", "ValueField")
+		case data.Value:
+			switch frag.Name {
+			case "Kind":
+				simple(frag.Field, Qual("go/token", "Token"), "token.Token", "ValueField")
+			case "Dir":
+				simple(frag.Field, Qual(DSTPATH, "ChanDir"), "dst.ChanDir", "ValueField")
+			case "Name":
+				simple(frag.Field, String(), "string", "ValueField")
+			default:
+				simple(frag.Field, Bool(), "bool", "ValueField")
+			}
+		case data.Bad:
+			simple(frag.LengthField, Int(), "int", "ValueField")
+		case data.PathDecoration:
+			simple(frag.Field, String(), "string", "ValueField")
+		case data.Decoration, data.SpecialDecoration, data.Scope, data.Object:
+			// ignore
+		default:
+			panic(fmt.Sprintf("unknown fragment type %T", frag))
+		}
+	}
+	return out
+}
+
+// initField returns the Init part of a node type, or nil if it has none.
+func initField(nodeName string) *data.Init {
+	for _, frag := range data.Info[nodeName] {
+		if frag, ok := frag.(data.Init); ok {
+			return &frag
+		}
+	}
+	return nil
+}
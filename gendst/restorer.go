@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/dave/dst/gendst/data"
 	. "github.com/dave/jennifer/jen"
@@ -18,7 +19,7 @@ func generateRestorer(names []string) error {
 	// 	case <type>:
 	// 		...
 	// 	default:
-	// 		panic(...)
+	// 		panic(&RestoreError{...})
 	// 	}
 	// }
 	f.Func().Params(Id("r").Op("*").Id("FileRestorer")).Id("restoreNode").Params(
@@ -32,11 +33,27 @@ func generateRestorer(names []string) error {
 			If(Id("allowDuplicate")).Block(
 				Return(Id("an")),
 			).Else().Block(
-				Panic(Qual("fmt", "Sprintf").Call(Lit("duplicate node: %#v"), Id("n"))),
+				Panic(Op("&").Id("RestoreError").Values(Dict{Id("Node"): Id("n"), Id("Reason"): Lit("duplicate node")})),
 			),
 		)
 		g.Switch(Id("n").Op(":=").Id("n").Assert(Id("type"))).BlockFunc(func(g *Group) {
 			for _, nodeName := range names {
+				if data.Synthetic[nodeName] {
+					g.Case(Op("*").Qual(DSTPATH, nodeName)).BlockFunc(func(g *Group) {
+						g.Comment("No ast node is created, so only the decorations are restored")
+						g.Id("r").Dot("applySpace").Call(Id("n"), Lit("Before"), Id("n").Dot("Decs").Dot("Before"))
+						for _, frag := range data.Info[nodeName] {
+							if frag, ok := frag.(data.Decoration); ok {
+								g.Line().Commentf("Decoration: %s", frag.Name)
+								g.Id("r").Dot("applyDecorations").Call(Nil(), Lit(frag.Name), Id("n").Dot("Decs").Dot(frag.Name), Lit(frag.Name == "End"))
+							}
+						}
+						g.Id("r").Dot("applySpace").Call(Id("n"), Lit("After"), Id("n").Dot("Decs").Dot("After"))
+						g.Line()
+						g.Return(Nil())
+					})
+					continue
+				}
 				g.Case(Op("*").Qual(DSTPATH, nodeName)).BlockFunc(func(g *Group) {
 					if nodeName == "Ident" {
 						g.Line()
@@ -113,20 +130,45 @@ func generateRestorer(names []string) error {
 									out.Elt = r.restoreNode(n.Elt).(ast.Expr)
 								}
 							*/
-							g.If(frag.Field.Get("n").Op("!=").Nil()).Block(
-								frag.Field.Get("out").Op("=").Id("r").Dot("restoreNode").Call(frag.Field.Get("n"), Lit(nodeName), Lit(frag.Field.FieldName()), Lit(frag.Type.TypeName()), Id("allowDuplicate")).Assert(frag.Type.Literal("go/ast")),
-							)
+							g.If(frag.Field.Get("n").Op("!=").Nil()).BlockFunc(func(g *Group) {
+								/*
+									if _, ok := n.Init.(*dst.CommentStmt); ok {
+										panic(&RestoreError{Node: n.Init, Reason: "CommentStmt outside a list of statements"})
+									}
+								*/
+								for _, name := range synthetics(frag.Type.TypeName()) {
+									// synthetic nodes have no ast node, so they can only be in lists
+									g.If(List(Id("_"), Id("ok")).Op(":=").Add(frag.Field.Get("n")).Assert(Op("*").Qual(DSTPATH, name)), Id("ok")).Block(
+										Panic(Op("&").Id("RestoreError").Values(Dict{Id("Node"): frag.Field.Get("n"), Id("Reason"): Lit(syntheticReasons[name])})),
+									)
+								}
+								g.Add(frag.Field.Get("out")).Op("=").Id("r").Dot("restoreNode").Call(frag.Field.Get("n"), Lit(nodeName), Lit(frag.Field.FieldName()), Lit(frag.Type.TypeName()), Id("allowDuplicate")).Assert(frag.Type.Literal("go/ast"))
+							})
 						case data.List:
 							if frag.NoRestore {
 								continue
 							}
 							g.Line().Commentf("List: %s", frag.Name)
-							g.For(List(Id("_"), Id("v")).Op(":=").Range().Add(frag.Field.Get("n"))).Block(
-								frag.Field.Get("out").Op("=").Append(
+							g.For(List(Id("_"), Id("v")).Op(":=").Range().Add(frag.Field.Get("n"))).BlockFunc(func(g *Group) {
+								/*
+									if _, ok := v.(*dst.CommentStmt); ok {
+										r.restoreNode(v, "BlockStmt", "List", "Stmt", allowDuplicate)
+										continue
+									}
+								*/
+								for _, name := range synthetics(frag.Elem.TypeName()) {
+									// synthetic nodes have no ast node, so only their decorations are
+									// restored and they are omitted from the list
+									g.If(List(Id("_"), Id("ok")).Op(":=").Id("v").Assert(Op("*").Qual(DSTPATH, name)), Id("ok")).Block(
+										Id("r").Dot("restoreNode").Call(Id("v"), Lit(nodeName), Lit(frag.Field.FieldName()), Lit(frag.Elem.TypeName()), Id("allowDuplicate")),
+										Continue(),
+									)
+								}
+								g.Add(frag.Field.Get("out")).Op("=").Append(
 									frag.Field.Get("out"),
 									Id("r").Dot("restoreNode").Call(Id("v"), Lit(nodeName), Lit(frag.Field.FieldName()), Lit(frag.Elem.TypeName()), Id("allowDuplicate")).Assert(frag.Elem.Literal("go/ast")),
-								),
-							)
+								)
+							})
 						case data.Map:
 							g.Line().Commentf("Map: %s", frag.Name)
 							g.Add(frag.Field.Get("out")).Op("=").Map(String()).Add(frag.Elem.Literal("go/ast")).Values()
@@ -171,10 +213,31 @@ func generateRestorer(names []string) error {
 				})
 			}
 			g.Default().Block(
-				Panic(Qual("fmt", "Sprintf").Call(Lit("%T"), Id("n"))),
+				Panic(Op("&").Id("RestoreError").Values(Dict{Id("Node"): Id("n"), Id("Reason"): Qual("fmt", "Sprintf").Call(Lit("unsupported node type %T"), Id("n"))})),
 			)
 		})
 	})
 
 	return f.Save("./decorator/restorer-generated.go")
 }
+
+// syntheticReasons is the RestoreError reason for each synthetic node type outside a list, the
+// same as reported by dst.Validate.
+var syntheticReasons = map[string]string{
+	"CommentStmt": "CommentStmt outside a list of statements",
+	"CommentDecl": "CommentDecl outside File.Decls",
+}
+
+// synthetics returns the sorted names of the synthetic node types that implement the interface
+// type (e.g. CommentStmt for Stmt).
+func synthetics(typeName string) []string {
+	var names []string
+	for name := range data.Synthetic {
+		switch {
+		case typeName == "Stmt" && data.Stmts[name], typeName == "Decl" && data.Decls[name]:
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
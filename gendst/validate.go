@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dave/dst/gendst/data"
+	. "github.com/dave/jennifer/jen"
+)
+
+// notest
+
+func generateValidate(names []string) error {
+
+	f := NewFilePathName(DSTPATH, "dst")
+
+	f.Comment("walk validates the decorations of n and visits the children of n.")
+	f.Func().Params(Id("v").Op("*").Id("validator")).Id("walk").Params(Id("n").Id("Node"), Id("path").String()).BlockFunc(func(g *Group) {
+		g.Switch(Id("n").Op(":=").Id("n").Assert(Id("type"))).BlockFunc(func(g *Group) {
+			for _, nodeName := range names {
+				g.Case(Op("*").Id(nodeName)).BlockFunc(func(g *Group) {
+					for _, frag := range data.Info[nodeName] {
+						switch frag := frag.(type) {
+						case data.Init:
+							// FuncDecl.Type is visited as a whole, so the inner fields are skipped below.
+							g.Line().Commentf("Init: %s", frag.Name)
+							g.Id("v").Dot("node").Call(frag.Field.Get("n"), Id("fieldPath").Call(Id("path"), Lit(frag.Field.FieldName())), Lit(nodeName), Lit(frag.Field.FieldName()))
+						case data.Decoration:
+							g.Line().Commentf("Decoration: %s", frag.Name)
+							g.Id("v").Dot("decorations").Call(Id("n"), Id("path"), Lit(frag.Name), Id("n").Dot("Decs").Dot(frag.Name))
+						case data.Node:
+							if isInner(frag.Field) {
+								continue
+							}
+							g.Line().Commentf("Node: %s", frag.Name)
+							g.Id("v").Dot("node").Call(frag.Field.Get("n"), Id("fieldPath").Call(Id("path"), Lit(frag.Field.FieldName())), Lit(nodeName), Lit(frag.Field.FieldName()))
+						case data.List:
+							if frag.NoRestore {
+								// File.Imports duplicates the import specs in File.Decls
+								continue
+							}
+							g.Line().Commentf("List: %s", frag.Name)
+							g.For(List(Id("i"), Id("c")).Op(":=").Range().Add(frag.Field.Get("n"))).Block(
+								Id("v").Dot("elem").Call(Id("c"), Id("indexPath").Call(Id("path"), Lit(frag.Field.FieldName()), Id("i")), Lit(nodeName), Lit(frag.Field.FieldName())),
+							)
+						case data.Map:
+							if frag.Elem.TypeName() == "Object" {
+								// Objects are ignored
+								continue
+							}
+							g.Line().Commentf("Map: %s", frag.Name)
+							g.Var().Id("keys").Index().String()
+							g.For(Id("k").Op(":=").Range().Add(frag.Field.Get("n"))).Block(
+								Id("keys").Op("=").Append(Id("keys"), Id("k")),
+							)
+							g.Qual("sort", "Strings").Call(Id("keys"))
+							g.For(List(Id("_"), Id("k")).Op(":=").Range().Id("keys")).Block(
+								Id("v").Dot("elem").Call(frag.Field.Get("n").Index(Id("k")), Id("keyPath").Call(Id("path"), Lit(frag.Field.FieldName()), Id("k")), Lit(nodeName), Lit(frag.Field.FieldName())),
+							)
+						case data.Token, data.String, data.Value, data.Bad, data.PathDecoration, data.Scope, data.Object, data.SpecialDecoration:
+							// checked by validator.check
+						default:
+							panic(fmt.Sprintf("unknown fragment type %T", frag))
+						}
+					}
+				})
+			}
+		})
+	})
+
+	return f.Save("./validate-generated.go")
+}
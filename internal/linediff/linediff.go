@@ -0,0 +1,59 @@
+// Package linediff finds the lines that differ between two texts using go-diff.
+package linediff
+
+import (
+	"strings"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// Op is a run of lines that are equal in both texts, deleted from the first or inserted in the
+// second. Each line includes its trailing newline, if it has one.
+type Op struct {
+	Type  diffmatchpatch.Operation
+	Lines []string
+}
+
+// Diff returns the line operations that change before into after.
+func Diff(before, after string) []Op {
+	// Each line is encoded as a rune so the diff is by line. DiffLinesToRunes isn't used because
+	// (in go-diff v1.2.0) it gives the same line different runes in each text.
+	var lines []string
+	runes := map[string]rune{}
+	encode := func(src string) []rune {
+		var out []rune
+		for _, line := range strings.SplitAfter(src, "\n") {
+			if line == "" {
+				continue
+			}
+			r, ok := runes[line]
+			if !ok {
+				r = rune(len(lines))
+				if r >= 0xD800 {
+					r += 0x800 // skip the surrogates, which aren't valid runes
+				}
+				runes[line] = r
+				lines = append(lines, line)
+			}
+			out = append(out, r)
+		}
+		return out
+	}
+	decode := func(r rune) string {
+		if r >= 0xD800 {
+			r -= 0x800
+		}
+		return lines[r]
+	}
+	diffs := diffmatchpatch.New().DiffMainRunes(encode(before), encode(after), false)
+
+	var ops []Op
+	for _, d := range diffs {
+		op := Op{Type: d.Type}
+		for _, r := range d.Text {
+			op.Lines = append(op.Lines, decode(r))
+		}
+		ops = append(ops, op)
+	}
+	return ops
+}
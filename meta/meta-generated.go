@@ -0,0 +1,2679 @@
+package meta
+
+import (
+	"github.com/dave/dst"
+	"go/token"
+)
+
+var nodes = []*Node{
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "Lbrack", "Len", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.ArrayType).Len; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Len",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.ArrayType).Len = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.ArrayType).Elt; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Elt",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.ArrayType).Elt = x
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "ArrayType",
+		New: func() dst.Node {
+			return &dst.ArrayType{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "Tok", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.AssignStmt).Lhs {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Interface: true,
+				Kind:      ListField,
+				Name:      "Lhs",
+				Set: func(n dst.Node, v interface{}) {
+					var out []dst.Expr
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(dst.Expr))
+					}
+					n.(*dst.AssignStmt).Lhs = out
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.AssignStmt).Tok
+				},
+				Kind: TokenField,
+				Name: "Tok",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.AssignStmt).Tok = v.(token.Token)
+				},
+				Type: "token.Token",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.AssignStmt).Rhs {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Interface: true,
+				Kind:      ListField,
+				Name:      "Rhs",
+				Set: func(n dst.Node, v interface{}) {
+					var out []dst.Expr
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(dst.Expr))
+					}
+					n.(*dst.AssignStmt).Rhs = out
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "AssignStmt",
+		New: func() dst.Node {
+			return &dst.AssignStmt{}
+		},
+	},
+	&Node{
+		Category:    "Decl",
+		Decorations: []string{"Start", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.BadDecl).Length
+				},
+				Kind: ValueField,
+				Name: "Length",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.BadDecl).Length = v.(int)
+				},
+				Type: "int",
+			},
+		},
+		Name: "BadDecl",
+		New: func() dst.Node {
+			return &dst.BadDecl{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.BadExpr).Length
+				},
+				Kind: ValueField,
+				Name: "Length",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.BadExpr).Length = v.(int)
+				},
+				Type: "int",
+			},
+		},
+		Name: "BadExpr",
+		New: func() dst.Node {
+			return &dst.BadExpr{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.BadStmt).Length
+				},
+				Kind: ValueField,
+				Name: "Length",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.BadStmt).Length = v.(int)
+				},
+				Type: "int",
+			},
+		},
+		Name: "BadStmt",
+		New: func() dst.Node {
+			return &dst.BadStmt{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.BasicLit).Value
+				},
+				Kind: ValueField,
+				Name: "Value",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.BasicLit).Value = v.(string)
+				},
+				Type: "string",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.BasicLit).Kind
+				},
+				Kind: ValueField,
+				Name: "Kind",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.BasicLit).Kind = v.(token.Token)
+				},
+				Type: "token.Token",
+			},
+		},
+		Name: "BasicLit",
+		New: func() dst.Node {
+			return &dst.BasicLit{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "X", "Op", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.BinaryExpr).X; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "X",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.BinaryExpr).X = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.BinaryExpr).Op
+				},
+				Kind: TokenField,
+				Name: "Op",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.BinaryExpr).Op = v.(token.Token)
+				},
+				Type: "token.Token",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.BinaryExpr).Y; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Y",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.BinaryExpr).Y = x
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "BinaryExpr",
+		New: func() dst.Node {
+			return &dst.BinaryExpr{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "Lbrace", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.BlockStmt).List {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Interface: true,
+				Kind:      ListField,
+				Name:      "List",
+				Set: func(n dst.Node, v interface{}) {
+					var out []dst.Stmt
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(dst.Stmt))
+					}
+					n.(*dst.BlockStmt).List = out
+				},
+				Type: "Stmt",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.BlockStmt).RbraceHasNoPos
+				},
+				Kind: TokenField,
+				Name: "RbraceHasNoPos",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.BlockStmt).RbraceHasNoPos = v.(bool)
+				},
+				Type: "bool",
+			},
+		},
+		Name: "BlockStmt",
+		New: func() dst.Node {
+			return &dst.BlockStmt{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "Tok", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.BranchStmt).Tok
+				},
+				Kind: TokenField,
+				Name: "Tok",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.BranchStmt).Tok = v.(token.Token)
+				},
+				Type: "token.Token",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.BranchStmt).Label; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Label",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.Ident)
+					n.(*dst.BranchStmt).Label = x
+				},
+				Type: "Ident",
+			},
+		},
+		Name: "BranchStmt",
+		New: func() dst.Node {
+			return &dst.BranchStmt{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "Fun", "Lparen", "Ellipsis", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.CallExpr).Fun; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Fun",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.CallExpr).Fun = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.CallExpr).Args {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Interface: true,
+				Kind:      ListField,
+				Name:      "Args",
+				Set: func(n dst.Node, v interface{}) {
+					var out []dst.Expr
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(dst.Expr))
+					}
+					n.(*dst.CallExpr).Args = out
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.CallExpr).Ellipsis
+				},
+				Kind: TokenField,
+				Name: "Ellipsis",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.CallExpr).Ellipsis = v.(bool)
+				},
+				Type: "bool",
+			},
+		},
+		Name: "CallExpr",
+		New: func() dst.Node {
+			return &dst.CallExpr{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "Case", "Colon", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.CaseClause).List {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Interface: true,
+				Kind:      ListField,
+				Name:      "List",
+				Set: func(n dst.Node, v interface{}) {
+					var out []dst.Expr
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(dst.Expr))
+					}
+					n.(*dst.CaseClause).List = out
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.CaseClause).Body {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Interface: true,
+				Kind:      ListField,
+				Name:      "Body",
+				Set: func(n dst.Node, v interface{}) {
+					var out []dst.Stmt
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(dst.Stmt))
+					}
+					n.(*dst.CaseClause).Body = out
+				},
+				Type: "Stmt",
+			},
+		},
+		Name: "CaseClause",
+		New: func() dst.Node {
+			return &dst.CaseClause{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "Begin", "Arrow", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.ChanType).Value; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Value",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.ChanType).Value = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.ChanType).Dir
+				},
+				Kind: ValueField,
+				Name: "Dir",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.ChanType).Dir = v.(dst.ChanDir)
+				},
+				Type: "dst.ChanDir",
+			},
+		},
+		Name: "ChanType",
+		New: func() dst.Node {
+			return &dst.ChanType{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "Case", "Comm", "Colon", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.CommClause).Comm; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Comm",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Stmt)
+					n.(*dst.CommClause).Comm = x
+				},
+				Type: "Stmt",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.CommClause).Body {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Interface: true,
+				Kind:      ListField,
+				Name:      "Body",
+				Set: func(n dst.Node, v interface{}) {
+					var out []dst.Stmt
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(dst.Stmt))
+					}
+					n.(*dst.CommClause).Body = out
+				},
+				Type: "Stmt",
+			},
+		},
+		Name: "CommClause",
+		New: func() dst.Node {
+			return &dst.CommClause{}
+		},
+	},
+	&Node{
+		Category:    "Decl",
+		Decorations: []string{"Start", "End"},
+		Fields:      []Field{},
+		Name:        "CommentDecl",
+		New: func() dst.Node {
+			return &dst.CommentDecl{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "End"},
+		Fields:      []Field{},
+		Name:        "CommentStmt",
+		New: func() dst.Node {
+			return &dst.CommentStmt{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "Type", "Lbrace", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.CompositeLit).Type; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Type",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.CompositeLit).Type = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.CompositeLit).Elts {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Interface: true,
+				Kind:      ListField,
+				Name:      "Elts",
+				Set: func(n dst.Node, v interface{}) {
+					var out []dst.Expr
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(dst.Expr))
+					}
+					n.(*dst.CompositeLit).Elts = out
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.CompositeLit).Incomplete
+				},
+				Kind: ValueField,
+				Name: "Incomplete",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.CompositeLit).Incomplete = v.(bool)
+				},
+				Type: "bool",
+			},
+		},
+		Name: "CompositeLit",
+		New: func() dst.Node {
+			return &dst.CompositeLit{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.DeclStmt).Decl; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Decl",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Decl)
+					n.(*dst.DeclStmt).Decl = x
+				},
+				Type: "Decl",
+			},
+		},
+		Name: "DeclStmt",
+		New: func() dst.Node {
+			return &dst.DeclStmt{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "Defer", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.DeferStmt).Call; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Call",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.CallExpr)
+					n.(*dst.DeferStmt).Call = x
+				},
+				Type: "CallExpr",
+			},
+		},
+		Name: "DeferStmt",
+		New: func() dst.Node {
+			return &dst.DeferStmt{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "Ellipsis", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.Ellipsis).Elt; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Elt",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.Ellipsis).Elt = x
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "Ellipsis",
+		New: func() dst.Node {
+			return &dst.Ellipsis{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.EmptyStmt).Implicit
+				},
+				Kind: ValueField,
+				Name: "Implicit",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.EmptyStmt).Implicit = v.(bool)
+				},
+				Type: "bool",
+			},
+		},
+		Name: "EmptyStmt",
+		New: func() dst.Node {
+			return &dst.EmptyStmt{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.ExprStmt).X; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "X",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.ExprStmt).X = x
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "ExprStmt",
+		New: func() dst.Node {
+			return &dst.ExprStmt{}
+		},
+	},
+	&Node{
+		Decorations: []string{"Start", "Type", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.Field).Names {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Kind: ListField,
+				Name: "Names",
+				Set: func(n dst.Node, v interface{}) {
+					var out []*dst.Ident
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(*dst.Ident))
+					}
+					n.(*dst.Field).Names = out
+				},
+				Type: "Ident",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.Field).Type; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Type",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.Field).Type = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.Field).Tag; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Tag",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.BasicLit)
+					n.(*dst.Field).Tag = x
+				},
+				Type: "BasicLit",
+			},
+		},
+		Name: "Field",
+		New: func() dst.Node {
+			return &dst.Field{}
+		},
+	},
+	&Node{
+		Decorations: []string{"Start", "Opening", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.FieldList).Opening
+				},
+				Kind: TokenField,
+				Name: "Opening",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.FieldList).Opening = v.(bool)
+				},
+				Type: "bool",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.FieldList).List {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Kind: ListField,
+				Name: "List",
+				Set: func(n dst.Node, v interface{}) {
+					var out []*dst.Field
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(*dst.Field))
+					}
+					n.(*dst.FieldList).List = out
+				},
+				Type: "Field",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.FieldList).Closing
+				},
+				Kind: TokenField,
+				Name: "Closing",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.FieldList).Closing = v.(bool)
+				},
+				Type: "bool",
+			},
+		},
+		Name: "FieldList",
+		New: func() dst.Node {
+			return &dst.FieldList{}
+		},
+	},
+	&Node{
+		Decorations: []string{"Start", "Package", "Name", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.File).Name; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Name",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.Ident)
+					n.(*dst.File).Name = x
+				},
+				Type: "Ident",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.File).Decls {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Interface: true,
+				Kind:      ListField,
+				Name:      "Decls",
+				Set: func(n dst.Node, v interface{}) {
+					var out []dst.Decl
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(dst.Decl))
+					}
+					n.(*dst.File).Decls = out
+				},
+				Type: "Decl",
+			},
+			{
+				Derived: true,
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.File).Imports {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Kind: ListField,
+				Name: "Imports",
+				Set: func(n dst.Node, v interface{}) {
+					var out []*dst.ImportSpec
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(*dst.ImportSpec))
+					}
+					n.(*dst.File).Imports = out
+				},
+				Type: "ImportSpec",
+			},
+		},
+		Name: "File",
+		New: func() dst.Node {
+			return &dst.File{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "For", "Init", "Cond", "Post", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.ForStmt).Init; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Init",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Stmt)
+					n.(*dst.ForStmt).Init = x
+				},
+				Type: "Stmt",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.ForStmt).Cond; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Cond",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.ForStmt).Cond = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.ForStmt).Post; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Post",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Stmt)
+					n.(*dst.ForStmt).Post = x
+				},
+				Type: "Stmt",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.ForStmt).Body; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Body",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.BlockStmt)
+					n.(*dst.ForStmt).Body = x
+				},
+				Type: "BlockStmt",
+			},
+		},
+		Name: "ForStmt",
+		New: func() dst.Node {
+			return &dst.ForStmt{}
+		},
+	},
+	&Node{
+		Category:    "Decl",
+		Decorations: []string{"Start", "Func", "Recv", "Name", "TypeParams", "Params", "Results", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.FuncDecl).Recv; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Recv",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.FieldList)
+					n.(*dst.FuncDecl).Recv = x
+				},
+				Type: "FieldList",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.FuncDecl).Name; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Name",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.Ident)
+					n.(*dst.FuncDecl).Name = x
+				},
+				Type: "Ident",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.FuncDecl).Type; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Type",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.FuncType)
+					n.(*dst.FuncDecl).Type = x
+				},
+				Type: "FuncType",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.FuncDecl).Body; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Body",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.BlockStmt)
+					n.(*dst.FuncDecl).Body = x
+				},
+				Type: "BlockStmt",
+			},
+		},
+		Name: "FuncDecl",
+		New: func() dst.Node {
+			return &dst.FuncDecl{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "Type", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.FuncLit).Type; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Type",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.FuncType)
+					n.(*dst.FuncLit).Type = x
+				},
+				Type: "FuncType",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.FuncLit).Body; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Body",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.BlockStmt)
+					n.(*dst.FuncLit).Body = x
+				},
+				Type: "BlockStmt",
+			},
+		},
+		Name: "FuncLit",
+		New: func() dst.Node {
+			return &dst.FuncLit{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "Func", "TypeParams", "Params", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.FuncType).Func
+				},
+				Kind: TokenField,
+				Name: "Func",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.FuncType).Func = v.(bool)
+				},
+				Type: "bool",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.FuncType).TypeParams; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "TypeParams",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.FieldList)
+					n.(*dst.FuncType).TypeParams = x
+				},
+				Type: "FieldList",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.FuncType).Params; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Params",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.FieldList)
+					n.(*dst.FuncType).Params = x
+				},
+				Type: "FieldList",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.FuncType).Results; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Results",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.FieldList)
+					n.(*dst.FuncType).Results = x
+				},
+				Type: "FieldList",
+			},
+		},
+		Name: "FuncType",
+		New: func() dst.Node {
+			return &dst.FuncType{}
+		},
+	},
+	&Node{
+		Category:    "Decl",
+		Decorations: []string{"Start", "Tok", "Lparen", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.GenDecl).Tok
+				},
+				Kind: TokenField,
+				Name: "Tok",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.GenDecl).Tok = v.(token.Token)
+				},
+				Type: "token.Token",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.GenDecl).Lparen
+				},
+				Kind: TokenField,
+				Name: "Lparen",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.GenDecl).Lparen = v.(bool)
+				},
+				Type: "bool",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.GenDecl).Specs {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Interface: true,
+				Kind:      ListField,
+				Name:      "Specs",
+				Set: func(n dst.Node, v interface{}) {
+					var out []dst.Spec
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(dst.Spec))
+					}
+					n.(*dst.GenDecl).Specs = out
+				},
+				Type: "Spec",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.GenDecl).Rparen
+				},
+				Kind: TokenField,
+				Name: "Rparen",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.GenDecl).Rparen = v.(bool)
+				},
+				Type: "bool",
+			},
+		},
+		Name: "GenDecl",
+		New: func() dst.Node {
+			return &dst.GenDecl{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "Go", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.GoStmt).Call; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Call",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.CallExpr)
+					n.(*dst.GoStmt).Call = x
+				},
+				Type: "CallExpr",
+			},
+		},
+		Name: "GoStmt",
+		New: func() dst.Node {
+			return &dst.GoStmt{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "X", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.Ident).Name
+				},
+				Kind: ValueField,
+				Name: "Name",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.Ident).Name = v.(string)
+				},
+				Type: "string",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.Ident).Path
+				},
+				Kind: ValueField,
+				Name: "Path",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.Ident).Path = v.(string)
+				},
+				Type: "string",
+			},
+		},
+		Name: "Ident",
+		New: func() dst.Node {
+			return &dst.Ident{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "If", "Init", "Cond", "Else", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.IfStmt).Init; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Init",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Stmt)
+					n.(*dst.IfStmt).Init = x
+				},
+				Type: "Stmt",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.IfStmt).Cond; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Cond",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.IfStmt).Cond = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.IfStmt).Body; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Body",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.BlockStmt)
+					n.(*dst.IfStmt).Body = x
+				},
+				Type: "BlockStmt",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.IfStmt).Else; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Else",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Stmt)
+					n.(*dst.IfStmt).Else = x
+				},
+				Type: "Stmt",
+			},
+		},
+		Name: "IfStmt",
+		New: func() dst.Node {
+			return &dst.IfStmt{}
+		},
+	},
+	&Node{
+		Category:    "Spec",
+		Decorations: []string{"Start", "Name", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.ImportSpec).Name; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Name",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.Ident)
+					n.(*dst.ImportSpec).Name = x
+				},
+				Type: "Ident",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.ImportSpec).Path; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Path",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.BasicLit)
+					n.(*dst.ImportSpec).Path = x
+				},
+				Type: "BasicLit",
+			},
+		},
+		Name: "ImportSpec",
+		New: func() dst.Node {
+			return &dst.ImportSpec{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "X", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.IncDecStmt).X; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "X",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.IncDecStmt).X = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.IncDecStmt).Tok
+				},
+				Kind: TokenField,
+				Name: "Tok",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.IncDecStmt).Tok = v.(token.Token)
+				},
+				Type: "token.Token",
+			},
+		},
+		Name: "IncDecStmt",
+		New: func() dst.Node {
+			return &dst.IncDecStmt{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "X", "Lbrack", "Index", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.IndexExpr).X; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "X",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.IndexExpr).X = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.IndexExpr).Index; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Index",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.IndexExpr).Index = x
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "IndexExpr",
+		New: func() dst.Node {
+			return &dst.IndexExpr{}
+		},
+	},
+	&Node{
+		Decorations: []string{"Start", "X", "Lbrack", "Indices", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.IndexListExpr).X; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "X",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.IndexListExpr).X = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.IndexListExpr).Indices {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Interface: true,
+				Kind:      ListField,
+				Name:      "Indices",
+				Set: func(n dst.Node, v interface{}) {
+					var out []dst.Expr
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(dst.Expr))
+					}
+					n.(*dst.IndexListExpr).Indices = out
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "IndexListExpr",
+		New: func() dst.Node {
+			return &dst.IndexListExpr{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "Interface", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.InterfaceType).Methods; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Methods",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.FieldList)
+					n.(*dst.InterfaceType).Methods = x
+				},
+				Type: "FieldList",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.InterfaceType).Incomplete
+				},
+				Kind: ValueField,
+				Name: "Incomplete",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.InterfaceType).Incomplete = v.(bool)
+				},
+				Type: "bool",
+			},
+		},
+		Name: "InterfaceType",
+		New: func() dst.Node {
+			return &dst.InterfaceType{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "Key", "Colon", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.KeyValueExpr).Key; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Key",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.KeyValueExpr).Key = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.KeyValueExpr).Value; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Value",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.KeyValueExpr).Value = x
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "KeyValueExpr",
+		New: func() dst.Node {
+			return &dst.KeyValueExpr{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "Label", "Colon", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.LabeledStmt).Label; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Label",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.Ident)
+					n.(*dst.LabeledStmt).Label = x
+				},
+				Type: "Ident",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.LabeledStmt).Stmt; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Stmt",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Stmt)
+					n.(*dst.LabeledStmt).Stmt = x
+				},
+				Type: "Stmt",
+			},
+		},
+		Name: "LabeledStmt",
+		New: func() dst.Node {
+			return &dst.LabeledStmt{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "Map", "Key", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.MapType).Key; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Key",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.MapType).Key = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.MapType).Value; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Value",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.MapType).Value = x
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "MapType",
+		New: func() dst.Node {
+			return &dst.MapType{}
+		},
+	},
+	&Node{
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.Package).Name
+				},
+				Kind: ValueField,
+				Name: "Name",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.Package).Name = v.(string)
+				},
+				Type: "string",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					out := map[string]dst.Node{}
+					for k, e := range n.(*dst.Package).Files {
+						out[k] = e
+					}
+					return out
+				},
+				Kind: MapField,
+				Name: "Files",
+				Set: func(n dst.Node, v interface{}) {
+					out := map[string]*dst.File{}
+					for k, e := range v.(map[string]dst.Node) {
+						out[k] = e.(*dst.File)
+					}
+					n.(*dst.Package).Files = out
+				},
+				Type: "File",
+			},
+		},
+		Name: "Package",
+		New: func() dst.Node {
+			return &dst.Package{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "Lparen", "X", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.ParenExpr).X; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "X",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.ParenExpr).X = x
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "ParenExpr",
+		New: func() dst.Node {
+			return &dst.ParenExpr{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "For", "Key", "Value", "Range", "X", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.RangeStmt).Key; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Key",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.RangeStmt).Key = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.RangeStmt).Value; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Value",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.RangeStmt).Value = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.RangeStmt).Tok
+				},
+				Kind: TokenField,
+				Name: "Tok",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.RangeStmt).Tok = v.(token.Token)
+				},
+				Type: "token.Token",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.RangeStmt).X; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "X",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.RangeStmt).X = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.RangeStmt).Body; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Body",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.BlockStmt)
+					n.(*dst.RangeStmt).Body = x
+				},
+				Type: "BlockStmt",
+			},
+		},
+		Name: "RangeStmt",
+		New: func() dst.Node {
+			return &dst.RangeStmt{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "Return", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.ReturnStmt).Results {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Interface: true,
+				Kind:      ListField,
+				Name:      "Results",
+				Set: func(n dst.Node, v interface{}) {
+					var out []dst.Expr
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(dst.Expr))
+					}
+					n.(*dst.ReturnStmt).Results = out
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "ReturnStmt",
+		New: func() dst.Node {
+			return &dst.ReturnStmt{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "Select", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.SelectStmt).Body; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Body",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.BlockStmt)
+					n.(*dst.SelectStmt).Body = x
+				},
+				Type: "BlockStmt",
+			},
+		},
+		Name: "SelectStmt",
+		New: func() dst.Node {
+			return &dst.SelectStmt{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "X", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.SelectorExpr).X; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "X",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.SelectorExpr).X = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.SelectorExpr).Sel; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Sel",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.Ident)
+					n.(*dst.SelectorExpr).Sel = x
+				},
+				Type: "Ident",
+			},
+		},
+		Name: "SelectorExpr",
+		New: func() dst.Node {
+			return &dst.SelectorExpr{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "Chan", "Arrow", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.SendStmt).Chan; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Chan",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.SendStmt).Chan = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.SendStmt).Value; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Value",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.SendStmt).Value = x
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "SendStmt",
+		New: func() dst.Node {
+			return &dst.SendStmt{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "X", "Lbrack", "Low", "High", "Max", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.SliceExpr).X; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "X",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.SliceExpr).X = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.SliceExpr).Low; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Low",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.SliceExpr).Low = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.SliceExpr).High; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "High",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.SliceExpr).High = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.SliceExpr).Max; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Max",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.SliceExpr).Max = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.SliceExpr).Slice3
+				},
+				Kind: ValueField,
+				Name: "Slice3",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.SliceExpr).Slice3 = v.(bool)
+				},
+				Type: "bool",
+			},
+		},
+		Name: "SliceExpr",
+		New: func() dst.Node {
+			return &dst.SliceExpr{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "Star", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.StarExpr).X; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "X",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.StarExpr).X = x
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "StarExpr",
+		New: func() dst.Node {
+			return &dst.StarExpr{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "Struct", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.StructType).Fields; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Fields",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.FieldList)
+					n.(*dst.StructType).Fields = x
+				},
+				Type: "FieldList",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.StructType).Incomplete
+				},
+				Kind: ValueField,
+				Name: "Incomplete",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.StructType).Incomplete = v.(bool)
+				},
+				Type: "bool",
+			},
+		},
+		Name: "StructType",
+		New: func() dst.Node {
+			return &dst.StructType{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "Switch", "Init", "Tag", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.SwitchStmt).Init; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Init",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Stmt)
+					n.(*dst.SwitchStmt).Init = x
+				},
+				Type: "Stmt",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.SwitchStmt).Tag; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Tag",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.SwitchStmt).Tag = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.SwitchStmt).Body; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Body",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.BlockStmt)
+					n.(*dst.SwitchStmt).Body = x
+				},
+				Type: "BlockStmt",
+			},
+		},
+		Name: "SwitchStmt",
+		New: func() dst.Node {
+			return &dst.SwitchStmt{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "X", "Lparen", "Type", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.TypeAssertExpr).X; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "X",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.TypeAssertExpr).X = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.TypeAssertExpr).Type; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Type",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.TypeAssertExpr).Type = x
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "TypeAssertExpr",
+		New: func() dst.Node {
+			return &dst.TypeAssertExpr{}
+		},
+	},
+	&Node{
+		Category:    "Spec",
+		Decorations: []string{"Start", "Name", "TypeParams", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.TypeSpec).Name; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Name",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.Ident)
+					n.(*dst.TypeSpec).Name = x
+				},
+				Type: "Ident",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.TypeSpec).Assign
+				},
+				Kind: TokenField,
+				Name: "Assign",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.TypeSpec).Assign = v.(bool)
+				},
+				Type: "bool",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.TypeSpec).TypeParams; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "TypeParams",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.FieldList)
+					n.(*dst.TypeSpec).TypeParams = x
+				},
+				Type: "FieldList",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.TypeSpec).Type; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Type",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.TypeSpec).Type = x
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "TypeSpec",
+		New: func() dst.Node {
+			return &dst.TypeSpec{}
+		},
+	},
+	&Node{
+		Category:    "Stmt",
+		Decorations: []string{"Start", "Switch", "Init", "Assign", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.TypeSwitchStmt).Init; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Init",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Stmt)
+					n.(*dst.TypeSwitchStmt).Init = x
+				},
+				Type: "Stmt",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.TypeSwitchStmt).Assign; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Assign",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Stmt)
+					n.(*dst.TypeSwitchStmt).Assign = x
+				},
+				Type: "Stmt",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.TypeSwitchStmt).Body; v != nil {
+						return v
+					}
+					return nil
+				},
+				Kind: NodeField,
+				Name: "Body",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(*dst.BlockStmt)
+					n.(*dst.TypeSwitchStmt).Body = x
+				},
+				Type: "BlockStmt",
+			},
+		},
+		Name: "TypeSwitchStmt",
+		New: func() dst.Node {
+			return &dst.TypeSwitchStmt{}
+		},
+	},
+	&Node{
+		Category:    "Expr",
+		Decorations: []string{"Start", "Op", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					return n.(*dst.UnaryExpr).Op
+				},
+				Kind: TokenField,
+				Name: "Op",
+				Set: func(n dst.Node, v interface{}) {
+					n.(*dst.UnaryExpr).Op = v.(token.Token)
+				},
+				Type: "token.Token",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.UnaryExpr).X; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "X",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.UnaryExpr).X = x
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "UnaryExpr",
+		New: func() dst.Node {
+			return &dst.UnaryExpr{}
+		},
+	},
+	&Node{
+		Category:    "Spec",
+		Decorations: []string{"Start", "Assign", "End"},
+		Fields: []Field{
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.ValueSpec).Names {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Kind: ListField,
+				Name: "Names",
+				Set: func(n dst.Node, v interface{}) {
+					var out []*dst.Ident
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(*dst.Ident))
+					}
+					n.(*dst.ValueSpec).Names = out
+				},
+				Type: "Ident",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					if v := n.(*dst.ValueSpec).Type; v != nil {
+						return v
+					}
+					return nil
+				},
+				Interface: true,
+				Kind:      NodeField,
+				Name:      "Type",
+				Set: func(n dst.Node, v interface{}) {
+					x, _ := v.(dst.Expr)
+					n.(*dst.ValueSpec).Type = x
+				},
+				Type: "Expr",
+			},
+			{
+				Get: func(n dst.Node) interface{} {
+					var out []dst.Node
+					for _, e := range n.(*dst.ValueSpec).Values {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e)
+					}
+					return out
+				},
+				Interface: true,
+				Kind:      ListField,
+				Name:      "Values",
+				Set: func(n dst.Node, v interface{}) {
+					var out []dst.Expr
+					for _, e := range v.([]dst.Node) {
+						if e == nil {
+							out = append(out, nil)
+							continue
+						}
+						out = append(out, e.(dst.Expr))
+					}
+					n.(*dst.ValueSpec).Values = out
+				},
+				Type: "Expr",
+			},
+		},
+		Name: "ValueSpec",
+		New: func() dst.Node {
+			return &dst.ValueSpec{}
+		},
+	},
+}
+
+// Of returns the metadata for the type of n, or nil if n is not a dst node.
+func Of(n dst.Node) *Node {
+	switch n.(type) {
+	case *dst.ArrayType:
+		return nodes[0]
+	case *dst.AssignStmt:
+		return nodes[1]
+	case *dst.BadDecl:
+		return nodes[2]
+	case *dst.BadExpr:
+		return nodes[3]
+	case *dst.BadStmt:
+		return nodes[4]
+	case *dst.BasicLit:
+		return nodes[5]
+	case *dst.BinaryExpr:
+		return nodes[6]
+	case *dst.BlockStmt:
+		return nodes[7]
+	case *dst.BranchStmt:
+		return nodes[8]
+	case *dst.CallExpr:
+		return nodes[9]
+	case *dst.CaseClause:
+		return nodes[10]
+	case *dst.ChanType:
+		return nodes[11]
+	case *dst.CommClause:
+		return nodes[12]
+	case *dst.CommentDecl:
+		return nodes[13]
+	case *dst.CommentStmt:
+		return nodes[14]
+	case *dst.CompositeLit:
+		return nodes[15]
+	case *dst.DeclStmt:
+		return nodes[16]
+	case *dst.DeferStmt:
+		return nodes[17]
+	case *dst.Ellipsis:
+		return nodes[18]
+	case *dst.EmptyStmt:
+		return nodes[19]
+	case *dst.ExprStmt:
+		return nodes[20]
+	case *dst.Field:
+		return nodes[21]
+	case *dst.FieldList:
+		return nodes[22]
+	case *dst.File:
+		return nodes[23]
+	case *dst.ForStmt:
+		return nodes[24]
+	case *dst.FuncDecl:
+		return nodes[25]
+	case *dst.FuncLit:
+		return nodes[26]
+	case *dst.FuncType:
+		return nodes[27]
+	case *dst.GenDecl:
+		return nodes[28]
+	case *dst.GoStmt:
+		return nodes[29]
+	case *dst.Ident:
+		return nodes[30]
+	case *dst.IfStmt:
+		return nodes[31]
+	case *dst.ImportSpec:
+		return nodes[32]
+	case *dst.IncDecStmt:
+		return nodes[33]
+	case *dst.IndexExpr:
+		return nodes[34]
+	case *dst.IndexListExpr:
+		return nodes[35]
+	case *dst.InterfaceType:
+		return nodes[36]
+	case *dst.KeyValueExpr:
+		return nodes[37]
+	case *dst.LabeledStmt:
+		return nodes[38]
+	case *dst.MapType:
+		return nodes[39]
+	case *dst.Package:
+		return nodes[40]
+	case *dst.ParenExpr:
+		return nodes[41]
+	case *dst.RangeStmt:
+		return nodes[42]
+	case *dst.ReturnStmt:
+		return nodes[43]
+	case *dst.SelectStmt:
+		return nodes[44]
+	case *dst.SelectorExpr:
+		return nodes[45]
+	case *dst.SendStmt:
+		return nodes[46]
+	case *dst.SliceExpr:
+		return nodes[47]
+	case *dst.StarExpr:
+		return nodes[48]
+	case *dst.StructType:
+		return nodes[49]
+	case *dst.SwitchStmt:
+		return nodes[50]
+	case *dst.TypeAssertExpr:
+		return nodes[51]
+	case *dst.TypeSpec:
+		return nodes[52]
+	case *dst.TypeSwitchStmt:
+		return nodes[53]
+	case *dst.UnaryExpr:
+		return nodes[54]
+	case *dst.ValueSpec:
+		return nodes[55]
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Package meta describes the fields and decoration points of each dst node type, so generic tools
+// (e.g. serializers, fuzzers and tree diffing) can walk and construct nodes without using reflect.
+// The metadata is generated from the same data as the dst package, so it stays in sync with the
+// node types.
+package meta
+
+import (
+	"sort"
+
+	"github.com/dave/dst"
+)
+
+// Kind is the kind of a field.
+type Kind int
+
+const (
+	NodeField  Kind = iota // A single child node. Get and Set use dst.Node (nil if the field is empty).
+	ListField              // A list of child nodes. Get and Set use []dst.Node, which may contain nil elements.
+	MapField               // A map of child nodes (e.g. Package.Files). Get and Set use map[string]dst.Node.
+	TokenField             // A token (token.Token), or a bool that records whether a token exists.
+	ValueField             // Any other value (e.g. Ident.Name, BasicLit.Kind or ChanType.Dir).
+)
+
+func (k Kind) String() string {
+	switch k {
+	case NodeField:
+		return "node"
+	case ListField:
+		return "list"
+	case MapField:
+		return "map"
+	case TokenField:
+		return "token"
+	case ValueField:
+		return "value"
+	}
+	return ""
+}
+
+// Node describes a node type.
+type Node struct {
+	Name        string          // The name of the type (e.g. "BinaryExpr")
+	Category    string          // "Expr", "Stmt", "Decl" or "Spec" if the type implements that interface
+	Fields      []Field         // The fields in the order they are rendered in the source
+	Decorations []string        // The names of the decoration points in the order they are rendered
+	New         func() dst.Node // Returns a new node of this type
+}
+
+// Field describes a field of a node type. Objects, scopes and decorations are not included.
+type Field struct {
+	Name string // The name of the field
+	Kind Kind
+	// For node, list and map fields, Type is the type of the child nodes: the name of an interface
+	// (e.g. "Expr") or a struct (e.g. "Ident"). For other fields, Type is the Go type of the field
+	// (e.g. "token.Token", "string" or "bool").
+	Type      string
+	Interface bool                            // Whether Type is the name of an interface
+	Derived   bool                            // The nodes are also in another field (e.g. File.Imports), so Children skips this field
+	Get       func(n dst.Node) interface{}    // Returns the value of the field. See Kind for the types used.
+	Set       func(n dst.Node, v interface{}) // Sets the value of the field. See Kind for the types used.
+}
+
+// Nodes returns the metadata for all node types in alphabetical order.
+func Nodes() []*Node {
+	return append([]*Node(nil), nodes...)
+}
+
+// Lookup returns the metadata for the node type with the name, or nil if it doesn't exist.
+func Lookup(name string) *Node {
+	for _, n := range nodes {
+		if n.Name == name {
+			return n
+		}
+	}
+	return nil
+}
+
+// Children returns the child nodes of n in the order they are rendered in the source. Nil
+// children are omitted.
+func Children(n dst.Node) []dst.Node {
+	m := Of(n)
+	if m == nil {
+		return nil
+	}
+	var out []dst.Node
+	for _, f := range m.Fields {
+		if f.Derived {
+			continue
+		}
+		switch f.Kind {
+		case NodeField:
+			if c, ok := f.Get(n).(dst.Node); ok {
+				out = append(out, c)
+			}
+		case ListField:
+			for _, c := range f.Get(n).([]dst.Node) {
+				if c != nil {
+					out = append(out, c)
+				}
+			}
+		case MapField:
+			children := f.Get(n).(map[string]dst.Node)
+			for _, k := range sortedKeys(children) {
+				out = append(out, children[k])
+			}
+		}
+	}
+	return out
+}
+
+func sortedKeys(m map[string]dst.Node) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,161 @@
+package meta_test
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+	"github.com/dave/dst/meta"
+)
+
+func TestChildren(t *testing.T) {
+	f, err := decorator.Parse(childrenSrc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expect []dst.Node
+	dst.Inspect(f, func(n dst.Node) bool {
+		if n != nil {
+			expect = append(expect, n)
+		}
+		return true
+	})
+	var found []dst.Node
+	var walk func(n dst.Node)
+	walk = func(n dst.Node) {
+		found = append(found, n)
+		for _, c := range meta.Children(n) {
+			walk(c)
+		}
+	}
+	walk(f)
+	if len(found) != len(expect) {
+		t.Fatalf("expected %d nodes, found %d", len(expect), len(found))
+	}
+	for i := range expect {
+		if found[i] != expect[i] {
+			t.Fatalf("node %d: expected %T, found %T", i, expect[i], found[i])
+		}
+	}
+}
+
+const childrenSrc = `package a
+
+import (
+	"fmt"
+	b "strings"
+)
+
+type T struct {
+	A, B int ` + "`json:\"a\"`" + `
+	C    map[string][]*T
+	D    chan<- func(...int) (int, error)
+	E    interface{ F() }
+}
+
+var x, y = [2]int{0: 1}, T{A: 1}
+
+func (t *T) G(i int) (err error) {
+	defer func() { recover() }()
+	go t.E.F()
+	switch v := interface{}(i).(type) {
+	case int:
+		fmt.Println(-v, v+1, b.Fields("a")[0:1], t.C["a"])
+	default:
+	}
+	select {
+	case c := <-t.D:
+		_ = c
+	}
+	for k := range t.C {
+		if k == "" {
+			continue
+		} else if k != "a" {
+			break
+		}
+	}
+	for i := 0; i < 10; i++ {
+	}
+	x, y = y, x
+	goto L
+L:
+	return (err)
+}
+`
+
+func TestConstruct(t *testing.T) {
+	set := func(n dst.Node, name string, v interface{}) {
+		for _, f := range meta.Of(n).Fields {
+			if f.Name == name {
+				f.Set(n, v)
+				return
+			}
+		}
+		t.Fatalf("field %s not found", name)
+	}
+	ident := func(name string) dst.Node {
+		n := meta.Lookup("Ident").New()
+		set(n, "Name", name)
+		return n
+	}
+	call := meta.Lookup("CallExpr").New()
+	set(call, "Fun", ident("a"))
+	set(call, "Args", []dst.Node{ident("b"), ident("c")})
+	n := meta.Lookup("BinaryExpr").New()
+	set(n, "X", call)
+	set(n, "Op", token.ADD)
+	set(n, "Y", ident("d"))
+
+	buf := &bytes.Buffer{}
+	if err := decorator.FprintNode(buf, n); err != nil {
+		t.Fatal(err)
+	}
+	if expect := "a(b, c) + d"; buf.String() != expect {
+		t.Errorf("expected %q, found %q", expect, buf.String())
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	m := meta.Lookup("BinaryExpr")
+	if m.Category != "Expr" {
+		t.Errorf("unexpected category %q", m.Category)
+	}
+	var fields []string
+	for _, f := range m.Fields {
+		fields = append(fields, f.Name+" "+f.Kind.String()+" "+f.Type)
+	}
+	expect := "[X node Expr Op token token.Token Y node Expr]"
+	if found := fmt.Sprint(fields); found != expect {
+		t.Errorf("expected %s, found %s", expect, found)
+	}
+	if found := fmt.Sprint(m.Decorations); found != "[Start X Op End]" {
+		t.Errorf("unexpected decorations %s", found)
+	}
+	if meta.Of(&dst.Ident{}) != meta.Lookup("Ident") || meta.Lookup("Foo") != nil {
+		t.Error("unexpected lookup result")
+	}
+	if len(meta.Nodes()) != len(meta.Nodes()[0:]) || meta.Nodes()[0].Name != "ArrayType" {
+		t.Error("unexpected nodes")
+	}
+}
+
+func TestListField_SetNil(t *testing.T) {
+	var args meta.Field
+	for _, f := range meta.Lookup("CallExpr").Fields {
+		if f.Name == "Args" {
+			args = f
+		}
+	}
+	n := &dst.CallExpr{Fun: dst.NewIdent("a"), Args: []dst.Expr{dst.NewIdent("b"), nil}}
+	v := args.Get(n).([]dst.Node)
+	if len(v) != 2 || v[1] != nil {
+		t.Fatalf("expected a nil element, found %v", v)
+	}
+	args.Set(n, v)
+	if len(n.Args) != 2 || n.Args[1] != nil {
+		t.Fatalf("expected a nil element, found %v", n.Args)
+	}
+}
@@ -0,0 +1,995 @@
+package dst
+
+import "sort"
+
+// walk validates the decorations of n and visits the children of n.
+func (v *validator) walk(n Node, path string) {
+	switch n := n.(type) {
+	case *ArrayType:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Lbrack
+		v.decorations(n, path, "Lbrack", n.Decs.Lbrack)
+
+		// Node: Len
+		v.node(n.Len, fieldPath(path, "Len"), "ArrayType", "Len")
+
+		// Decoration: Len
+		v.decorations(n, path, "Len", n.Decs.Len)
+
+		// Node: Elt
+		v.node(n.Elt, fieldPath(path, "Elt"), "ArrayType", "Elt")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *AssignStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// List: Lhs
+		for i, c := range n.Lhs {
+			v.elem(c, indexPath(path, "Lhs", i), "AssignStmt", "Lhs")
+		}
+
+		// Decoration: Tok
+		v.decorations(n, path, "Tok", n.Decs.Tok)
+
+		// List: Rhs
+		for i, c := range n.Rhs {
+			v.elem(c, indexPath(path, "Rhs", i), "AssignStmt", "Rhs")
+		}
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *BadDecl:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *BadExpr:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *BadStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *BasicLit:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *BinaryExpr:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: X
+		v.node(n.X, fieldPath(path, "X"), "BinaryExpr", "X")
+
+		// Decoration: X
+		v.decorations(n, path, "X", n.Decs.X)
+
+		// Decoration: Op
+		v.decorations(n, path, "Op", n.Decs.Op)
+
+		// Node: Y
+		v.node(n.Y, fieldPath(path, "Y"), "BinaryExpr", "Y")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *BlockStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Lbrace
+		v.decorations(n, path, "Lbrace", n.Decs.Lbrace)
+
+		// List: List
+		for i, c := range n.List {
+			v.elem(c, indexPath(path, "List", i), "BlockStmt", "List")
+		}
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *BranchStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Tok
+		v.decorations(n, path, "Tok", n.Decs.Tok)
+
+		// Node: Label
+		v.node(n.Label, fieldPath(path, "Label"), "BranchStmt", "Label")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *CallExpr:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: Fun
+		v.node(n.Fun, fieldPath(path, "Fun"), "CallExpr", "Fun")
+
+		// Decoration: Fun
+		v.decorations(n, path, "Fun", n.Decs.Fun)
+
+		// Decoration: Lparen
+		v.decorations(n, path, "Lparen", n.Decs.Lparen)
+
+		// List: Args
+		for i, c := range n.Args {
+			v.elem(c, indexPath(path, "Args", i), "CallExpr", "Args")
+		}
+
+		// Decoration: Ellipsis
+		v.decorations(n, path, "Ellipsis", n.Decs.Ellipsis)
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *CaseClause:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Case
+		v.decorations(n, path, "Case", n.Decs.Case)
+
+		// List: List
+		for i, c := range n.List {
+			v.elem(c, indexPath(path, "List", i), "CaseClause", "List")
+		}
+
+		// Decoration: Colon
+		v.decorations(n, path, "Colon", n.Decs.Colon)
+
+		// List: Body
+		for i, c := range n.Body {
+			v.elem(c, indexPath(path, "Body", i), "CaseClause", "Body")
+		}
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *ChanType:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Begin
+		v.decorations(n, path, "Begin", n.Decs.Begin)
+
+		// Decoration: Arrow
+		v.decorations(n, path, "Arrow", n.Decs.Arrow)
+
+		// Node: Value
+		v.node(n.Value, fieldPath(path, "Value"), "ChanType", "Value")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *CommClause:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Case
+		v.decorations(n, path, "Case", n.Decs.Case)
+
+		// Node: Comm
+		v.node(n.Comm, fieldPath(path, "Comm"), "CommClause", "Comm")
+
+		// Decoration: Comm
+		v.decorations(n, path, "Comm", n.Decs.Comm)
+
+		// Decoration: Colon
+		v.decorations(n, path, "Colon", n.Decs.Colon)
+
+		// List: Body
+		for i, c := range n.Body {
+			v.elem(c, indexPath(path, "Body", i), "CommClause", "Body")
+		}
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *CommentDecl:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *CommentStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *CompositeLit:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: Type
+		v.node(n.Type, fieldPath(path, "Type"), "CompositeLit", "Type")
+
+		// Decoration: Type
+		v.decorations(n, path, "Type", n.Decs.Type)
+
+		// Decoration: Lbrace
+		v.decorations(n, path, "Lbrace", n.Decs.Lbrace)
+
+		// List: Elts
+		for i, c := range n.Elts {
+			v.elem(c, indexPath(path, "Elts", i), "CompositeLit", "Elts")
+		}
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *DeclStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: Decl
+		v.node(n.Decl, fieldPath(path, "Decl"), "DeclStmt", "Decl")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *DeferStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Defer
+		v.decorations(n, path, "Defer", n.Decs.Defer)
+
+		// Node: Call
+		v.node(n.Call, fieldPath(path, "Call"), "DeferStmt", "Call")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *Ellipsis:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Ellipsis
+		v.decorations(n, path, "Ellipsis", n.Decs.Ellipsis)
+
+		// Node: Elt
+		v.node(n.Elt, fieldPath(path, "Elt"), "Ellipsis", "Elt")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *EmptyStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *ExprStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: X
+		v.node(n.X, fieldPath(path, "X"), "ExprStmt", "X")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *Field:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// List: Names
+		for i, c := range n.Names {
+			v.elem(c, indexPath(path, "Names", i), "Field", "Names")
+		}
+
+		// Node: Type
+		v.node(n.Type, fieldPath(path, "Type"), "Field", "Type")
+
+		// Decoration: Type
+		v.decorations(n, path, "Type", n.Decs.Type)
+
+		// Node: Tag
+		v.node(n.Tag, fieldPath(path, "Tag"), "Field", "Tag")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *FieldList:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Opening
+		v.decorations(n, path, "Opening", n.Decs.Opening)
+
+		// List: List
+		for i, c := range n.List {
+			v.elem(c, indexPath(path, "List", i), "FieldList", "List")
+		}
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *File:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Package
+		v.decorations(n, path, "Package", n.Decs.Package)
+
+		// Node: Name
+		v.node(n.Name, fieldPath(path, "Name"), "File", "Name")
+
+		// Decoration: Name
+		v.decorations(n, path, "Name", n.Decs.Name)
+
+		// List: Decls
+		for i, c := range n.Decls {
+			v.elem(c, indexPath(path, "Decls", i), "File", "Decls")
+		}
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *ForStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: For
+		v.decorations(n, path, "For", n.Decs.For)
+
+		// Node: Init
+		v.node(n.Init, fieldPath(path, "Init"), "ForStmt", "Init")
+
+		// Decoration: Init
+		v.decorations(n, path, "Init", n.Decs.Init)
+
+		// Node: Cond
+		v.node(n.Cond, fieldPath(path, "Cond"), "ForStmt", "Cond")
+
+		// Decoration: Cond
+		v.decorations(n, path, "Cond", n.Decs.Cond)
+
+		// Node: Post
+		v.node(n.Post, fieldPath(path, "Post"), "ForStmt", "Post")
+
+		// Decoration: Post
+		v.decorations(n, path, "Post", n.Decs.Post)
+
+		// Node: Body
+		v.node(n.Body, fieldPath(path, "Body"), "ForStmt", "Body")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *FuncDecl:
+
+		// Init: Type
+		v.node(n.Type, fieldPath(path, "Type"), "FuncDecl", "Type")
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Func
+		v.decorations(n, path, "Func", n.Decs.Func)
+
+		// Node: Recv
+		v.node(n.Recv, fieldPath(path, "Recv"), "FuncDecl", "Recv")
+
+		// Decoration: Recv
+		v.decorations(n, path, "Recv", n.Decs.Recv)
+
+		// Node: Name
+		v.node(n.Name, fieldPath(path, "Name"), "FuncDecl", "Name")
+
+		// Decoration: Name
+		v.decorations(n, path, "Name", n.Decs.Name)
+
+		// Decoration: TypeParams
+		v.decorations(n, path, "TypeParams", n.Decs.TypeParams)
+
+		// Decoration: Params
+		v.decorations(n, path, "Params", n.Decs.Params)
+
+		// Decoration: Results
+		v.decorations(n, path, "Results", n.Decs.Results)
+
+		// Node: Body
+		v.node(n.Body, fieldPath(path, "Body"), "FuncDecl", "Body")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *FuncLit:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: Type
+		v.node(n.Type, fieldPath(path, "Type"), "FuncLit", "Type")
+
+		// Decoration: Type
+		v.decorations(n, path, "Type", n.Decs.Type)
+
+		// Node: Body
+		v.node(n.Body, fieldPath(path, "Body"), "FuncLit", "Body")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *FuncType:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Func
+		v.decorations(n, path, "Func", n.Decs.Func)
+
+		// Node: TypeParams
+		v.node(n.TypeParams, fieldPath(path, "TypeParams"), "FuncType", "TypeParams")
+
+		// Decoration: TypeParams
+		v.decorations(n, path, "TypeParams", n.Decs.TypeParams)
+
+		// Node: Params
+		v.node(n.Params, fieldPath(path, "Params"), "FuncType", "Params")
+
+		// Decoration: Params
+		v.decorations(n, path, "Params", n.Decs.Params)
+
+		// Node: Results
+		v.node(n.Results, fieldPath(path, "Results"), "FuncType", "Results")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *GenDecl:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Tok
+		v.decorations(n, path, "Tok", n.Decs.Tok)
+
+		// Decoration: Lparen
+		v.decorations(n, path, "Lparen", n.Decs.Lparen)
+
+		// List: Specs
+		for i, c := range n.Specs {
+			v.elem(c, indexPath(path, "Specs", i), "GenDecl", "Specs")
+		}
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *GoStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Go
+		v.decorations(n, path, "Go", n.Decs.Go)
+
+		// Node: Call
+		v.node(n.Call, fieldPath(path, "Call"), "GoStmt", "Call")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *Ident:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: X
+		v.decorations(n, path, "X", n.Decs.X)
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *IfStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: If
+		v.decorations(n, path, "If", n.Decs.If)
+
+		// Node: Init
+		v.node(n.Init, fieldPath(path, "Init"), "IfStmt", "Init")
+
+		// Decoration: Init
+		v.decorations(n, path, "Init", n.Decs.Init)
+
+		// Node: Cond
+		v.node(n.Cond, fieldPath(path, "Cond"), "IfStmt", "Cond")
+
+		// Decoration: Cond
+		v.decorations(n, path, "Cond", n.Decs.Cond)
+
+		// Node: Body
+		v.node(n.Body, fieldPath(path, "Body"), "IfStmt", "Body")
+
+		// Decoration: Else
+		v.decorations(n, path, "Else", n.Decs.Else)
+
+		// Node: Else
+		v.node(n.Else, fieldPath(path, "Else"), "IfStmt", "Else")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *ImportSpec:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: Name
+		v.node(n.Name, fieldPath(path, "Name"), "ImportSpec", "Name")
+
+		// Decoration: Name
+		v.decorations(n, path, "Name", n.Decs.Name)
+
+		// Node: Path
+		v.node(n.Path, fieldPath(path, "Path"), "ImportSpec", "Path")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *IncDecStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: X
+		v.node(n.X, fieldPath(path, "X"), "IncDecStmt", "X")
+
+		// Decoration: X
+		v.decorations(n, path, "X", n.Decs.X)
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *IndexExpr:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: X
+		v.node(n.X, fieldPath(path, "X"), "IndexExpr", "X")
+
+		// Decoration: X
+		v.decorations(n, path, "X", n.Decs.X)
+
+		// Decoration: Lbrack
+		v.decorations(n, path, "Lbrack", n.Decs.Lbrack)
+
+		// Node: Index
+		v.node(n.Index, fieldPath(path, "Index"), "IndexExpr", "Index")
+
+		// Decoration: Index
+		v.decorations(n, path, "Index", n.Decs.Index)
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *IndexListExpr:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: X
+		v.node(n.X, fieldPath(path, "X"), "IndexListExpr", "X")
+
+		// Decoration: X
+		v.decorations(n, path, "X", n.Decs.X)
+
+		// Decoration: Lbrack
+		v.decorations(n, path, "Lbrack", n.Decs.Lbrack)
+
+		// List: Indices
+		for i, c := range n.Indices {
+			v.elem(c, indexPath(path, "Indices", i), "IndexListExpr", "Indices")
+		}
+
+		// Decoration: Indices
+		v.decorations(n, path, "Indices", n.Decs.Indices)
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *InterfaceType:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Interface
+		v.decorations(n, path, "Interface", n.Decs.Interface)
+
+		// Node: Methods
+		v.node(n.Methods, fieldPath(path, "Methods"), "InterfaceType", "Methods")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *KeyValueExpr:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: Key
+		v.node(n.Key, fieldPath(path, "Key"), "KeyValueExpr", "Key")
+
+		// Decoration: Key
+		v.decorations(n, path, "Key", n.Decs.Key)
+
+		// Decoration: Colon
+		v.decorations(n, path, "Colon", n.Decs.Colon)
+
+		// Node: Value
+		v.node(n.Value, fieldPath(path, "Value"), "KeyValueExpr", "Value")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *LabeledStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: Label
+		v.node(n.Label, fieldPath(path, "Label"), "LabeledStmt", "Label")
+
+		// Decoration: Label
+		v.decorations(n, path, "Label", n.Decs.Label)
+
+		// Decoration: Colon
+		v.decorations(n, path, "Colon", n.Decs.Colon)
+
+		// Node: Stmt
+		v.node(n.Stmt, fieldPath(path, "Stmt"), "LabeledStmt", "Stmt")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *MapType:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Map
+		v.decorations(n, path, "Map", n.Decs.Map)
+
+		// Node: Key
+		v.node(n.Key, fieldPath(path, "Key"), "MapType", "Key")
+
+		// Decoration: Key
+		v.decorations(n, path, "Key", n.Decs.Key)
+
+		// Node: Value
+		v.node(n.Value, fieldPath(path, "Value"), "MapType", "Value")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *Package:
+
+		// Map: Files
+		var keys []string
+		for k := range n.Files {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			v.elem(n.Files[k], keyPath(path, "Files", k), "Package", "Files")
+		}
+	case *ParenExpr:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Lparen
+		v.decorations(n, path, "Lparen", n.Decs.Lparen)
+
+		// Node: X
+		v.node(n.X, fieldPath(path, "X"), "ParenExpr", "X")
+
+		// Decoration: X
+		v.decorations(n, path, "X", n.Decs.X)
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *RangeStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: For
+		v.decorations(n, path, "For", n.Decs.For)
+
+		// Node: Key
+		v.node(n.Key, fieldPath(path, "Key"), "RangeStmt", "Key")
+
+		// Decoration: Key
+		v.decorations(n, path, "Key", n.Decs.Key)
+
+		// Node: Value
+		v.node(n.Value, fieldPath(path, "Value"), "RangeStmt", "Value")
+
+		// Decoration: Value
+		v.decorations(n, path, "Value", n.Decs.Value)
+
+		// Decoration: Range
+		v.decorations(n, path, "Range", n.Decs.Range)
+
+		// Node: X
+		v.node(n.X, fieldPath(path, "X"), "RangeStmt", "X")
+
+		// Decoration: X
+		v.decorations(n, path, "X", n.Decs.X)
+
+		// Node: Body
+		v.node(n.Body, fieldPath(path, "Body"), "RangeStmt", "Body")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *ReturnStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Return
+		v.decorations(n, path, "Return", n.Decs.Return)
+
+		// List: Results
+		for i, c := range n.Results {
+			v.elem(c, indexPath(path, "Results", i), "ReturnStmt", "Results")
+		}
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *SelectStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Select
+		v.decorations(n, path, "Select", n.Decs.Select)
+
+		// Node: Body
+		v.node(n.Body, fieldPath(path, "Body"), "SelectStmt", "Body")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *SelectorExpr:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: X
+		v.node(n.X, fieldPath(path, "X"), "SelectorExpr", "X")
+
+		// Decoration: X
+		v.decorations(n, path, "X", n.Decs.X)
+
+		// Node: Sel
+		v.node(n.Sel, fieldPath(path, "Sel"), "SelectorExpr", "Sel")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *SendStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: Chan
+		v.node(n.Chan, fieldPath(path, "Chan"), "SendStmt", "Chan")
+
+		// Decoration: Chan
+		v.decorations(n, path, "Chan", n.Decs.Chan)
+
+		// Decoration: Arrow
+		v.decorations(n, path, "Arrow", n.Decs.Arrow)
+
+		// Node: Value
+		v.node(n.Value, fieldPath(path, "Value"), "SendStmt", "Value")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *SliceExpr:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: X
+		v.node(n.X, fieldPath(path, "X"), "SliceExpr", "X")
+
+		// Decoration: X
+		v.decorations(n, path, "X", n.Decs.X)
+
+		// Decoration: Lbrack
+		v.decorations(n, path, "Lbrack", n.Decs.Lbrack)
+
+		// Node: Low
+		v.node(n.Low, fieldPath(path, "Low"), "SliceExpr", "Low")
+
+		// Decoration: Low
+		v.decorations(n, path, "Low", n.Decs.Low)
+
+		// Node: High
+		v.node(n.High, fieldPath(path, "High"), "SliceExpr", "High")
+
+		// Decoration: High
+		v.decorations(n, path, "High", n.Decs.High)
+
+		// Node: Max
+		v.node(n.Max, fieldPath(path, "Max"), "SliceExpr", "Max")
+
+		// Decoration: Max
+		v.decorations(n, path, "Max", n.Decs.Max)
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *StarExpr:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Star
+		v.decorations(n, path, "Star", n.Decs.Star)
+
+		// Node: X
+		v.node(n.X, fieldPath(path, "X"), "StarExpr", "X")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *StructType:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Struct
+		v.decorations(n, path, "Struct", n.Decs.Struct)
+
+		// Node: Fields
+		v.node(n.Fields, fieldPath(path, "Fields"), "StructType", "Fields")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *SwitchStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Switch
+		v.decorations(n, path, "Switch", n.Decs.Switch)
+
+		// Node: Init
+		v.node(n.Init, fieldPath(path, "Init"), "SwitchStmt", "Init")
+
+		// Decoration: Init
+		v.decorations(n, path, "Init", n.Decs.Init)
+
+		// Node: Tag
+		v.node(n.Tag, fieldPath(path, "Tag"), "SwitchStmt", "Tag")
+
+		// Decoration: Tag
+		v.decorations(n, path, "Tag", n.Decs.Tag)
+
+		// Node: Body
+		v.node(n.Body, fieldPath(path, "Body"), "SwitchStmt", "Body")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *TypeAssertExpr:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: X
+		v.node(n.X, fieldPath(path, "X"), "TypeAssertExpr", "X")
+
+		// Decoration: X
+		v.decorations(n, path, "X", n.Decs.X)
+
+		// Decoration: Lparen
+		v.decorations(n, path, "Lparen", n.Decs.Lparen)
+
+		// Node: Type
+		v.node(n.Type, fieldPath(path, "Type"), "TypeAssertExpr", "Type")
+
+		// Decoration: Type
+		v.decorations(n, path, "Type", n.Decs.Type)
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *TypeSpec:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Node: Name
+		v.node(n.Name, fieldPath(path, "Name"), "TypeSpec", "Name")
+
+		// Decoration: Name
+		v.decorations(n, path, "Name", n.Decs.Name)
+
+		// Node: TypeParams
+		v.node(n.TypeParams, fieldPath(path, "TypeParams"), "TypeSpec", "TypeParams")
+
+		// Decoration: TypeParams
+		v.decorations(n, path, "TypeParams", n.Decs.TypeParams)
+
+		// Node: Type
+		v.node(n.Type, fieldPath(path, "Type"), "TypeSpec", "Type")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *TypeSwitchStmt:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Switch
+		v.decorations(n, path, "Switch", n.Decs.Switch)
+
+		// Node: Init
+		v.node(n.Init, fieldPath(path, "Init"), "TypeSwitchStmt", "Init")
+
+		// Decoration: Init
+		v.decorations(n, path, "Init", n.Decs.Init)
+
+		// Node: Assign
+		v.node(n.Assign, fieldPath(path, "Assign"), "TypeSwitchStmt", "Assign")
+
+		// Decoration: Assign
+		v.decorations(n, path, "Assign", n.Decs.Assign)
+
+		// Node: Body
+		v.node(n.Body, fieldPath(path, "Body"), "TypeSwitchStmt", "Body")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *UnaryExpr:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// Decoration: Op
+		v.decorations(n, path, "Op", n.Decs.Op)
+
+		// Node: X
+		v.node(n.X, fieldPath(path, "X"), "UnaryExpr", "X")
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	case *ValueSpec:
+
+		// Decoration: Start
+		v.decorations(n, path, "Start", n.Decs.Start)
+
+		// List: Names
+		for i, c := range n.Names {
+			v.elem(c, indexPath(path, "Names", i), "ValueSpec", "Names")
+		}
+
+		// Node: Type
+		v.node(n.Type, fieldPath(path, "Type"), "ValueSpec", "Type")
+
+		// Decoration: Assign
+		v.decorations(n, path, "Assign", n.Decs.Assign)
+
+		// List: Values
+		for i, c := range n.Values {
+			v.elem(c, indexPath(path, "Values", i), "ValueSpec", "Values")
+		}
+
+		// Decoration: End
+		v.decorations(n, path, "End", n.Decs.End)
+	}
+}
@@ -0,0 +1,320 @@
+package dst
+
+import (
+	"fmt"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ValidationError describes a problem in a tree found by Validate.
+type ValidationError struct {
+	Path string // The location of the node relative to the root, e.g. "Decls[0].Body.List[1]"
+	Node Node   // The node with the problem
+	Msg  string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Msg
+	}
+	return e.Path + ": " + e.Msg
+}
+
+// Validate checks a tree for mistakes that would otherwise cause a panic or invalid output when
+// it is restored. The checks include:
+//
+//   - A node appears more than once in the tree.
+//   - Token fields that are invalid or don't match other fields (e.g. GenDecl.Tok and the type of
+//     the specs, or RangeStmt.Tok and Key).
+//   - A CaseClause outside a switch statement or a CommClause outside a select statement.
+//   - A CommentStmt outside a list of statements or a CommentDecl outside File.Decls.
+//   - Ident.Path set on an Ident that can't be a qualified identifier (e.g. FuncDecl.Name).
+//   - Decorations that aren't a newline or a single well-formed comment.
+//
+// All errors are *ValidationError. Nil is returned if no problems are found.
+func Validate(node Node) []error {
+	v := &validator{seen: map[Node]string{}, clauses: map[Node]bool{}}
+	v.node(node, "", "", "")
+	return v.errors
+}
+
+type validator struct {
+	seen    map[Node]string // path of each node that has been visited
+	clauses map[Node]bool   // case and comm clauses in the body of a switch or select statement
+	errors  []error
+}
+
+func (v *validator) errorf(n Node, path, format string, args ...interface{}) {
+	v.errors = append(v.errors, &ValidationError{Path: path, Node: n, Msg: fmt.Sprintf(format, args...)})
+}
+
+// node validates an optional child node and its children.
+func (v *validator) node(n Node, path, parentName, parentField string) {
+	if isNilNode(n) {
+		return
+	}
+	if first, ok := v.seen[n]; ok {
+		v.errorf(n, path, "%T is also at %s", n, pathString(first))
+		return
+	}
+	v.seen[n] = path
+	if d := n.Decorations(); d != nil {
+		v.space(n, path, "Before", d.Before)
+		v.space(n, path, "After", d.After)
+	}
+	v.check(n, path, parentName, parentField)
+	v.walk(n, path)
+}
+
+// elem validates a node in a list or map, which must not be nil.
+func (v *validator) elem(n Node, path, parentName, parentField string) {
+	if isNilNode(n) {
+		v.errorf(n, path, "nil node in %s.%s", parentName, parentField)
+		return
+	}
+	v.node(n, path, parentName, parentField)
+}
+
+func (v *validator) space(n Node, path, name string, space SpaceType) {
+	if space != None && space != NewLine && space != EmptyLine {
+		v.errorf(n, path, "invalid %s space %d", name, space)
+	}
+}
+
+func (v *validator) decorations(n Node, path, name string, decs Decorations) {
+	for _, d := range decs {
+		switch {
+		case d == "\n":
+		case strings.HasPrefix(d, "//") && !strings.Contains(d, "\n"):
+		case strings.HasPrefix(d, "/*") && len(d) >= 4 && strings.Index(d, "*/") == len(d)-2:
+		default:
+			v.errorf(n, path, "decoration %q in %s is not a newline or a comment", d, name)
+		}
+	}
+}
+
+// check validates the fields of n that aren't child nodes.
+func (v *validator) check(n Node, path, parentName, parentField string) {
+	switch n := n.(type) {
+	case *Ident:
+		if !token.IsIdentifier(n.Name) && !(n.Name == "." && parentName == "ImportSpec") {
+			v.errorf(n, path, "invalid identifier %q", n.Name)
+		}
+		if n.Path != "" {
+			if unqualified[parentName+"."+parentField] {
+				v.errorf(n, path, "Path %s set on Ident %s in %s.%s", n.Path, n.Name, parentName, parentField)
+			}
+			if strings.ContainsAny(n.Path, " \t\n\"'`\\") {
+				v.errorf(n, path, "invalid Path %q", n.Path)
+			}
+		}
+	case *BasicLit:
+		switch n.Kind {
+		case token.INT, token.FLOAT, token.IMAG, token.CHAR, token.STRING:
+		default:
+			v.errorf(n, path, "invalid BasicLit.Kind %s", n.Kind)
+		}
+		if n.Value == "" {
+			v.errorf(n, path, "empty BasicLit.Value")
+		}
+	case *BinaryExpr:
+		if n.Op.Precedence() == token.LowestPrec {
+			v.errorf(n, path, "invalid BinaryExpr.Op %s", n.Op)
+		}
+	case *UnaryExpr:
+		switch n.Op {
+		case token.ADD, token.SUB, token.NOT, token.XOR, token.MUL, token.AND, token.ARROW, token.TILDE:
+		default:
+			v.errorf(n, path, "invalid UnaryExpr.Op %s", n.Op)
+		}
+	case *ChanType:
+		if n.Dir != SEND && n.Dir != RECV && n.Dir != SEND|RECV {
+			v.errorf(n, path, "invalid ChanType.Dir %d", n.Dir)
+		}
+	case *AssignStmt:
+		switch n.Tok {
+		case token.ASSIGN, token.DEFINE,
+			token.ADD_ASSIGN, token.SUB_ASSIGN, token.MUL_ASSIGN, token.QUO_ASSIGN, token.REM_ASSIGN,
+			token.AND_ASSIGN, token.OR_ASSIGN, token.XOR_ASSIGN, token.SHL_ASSIGN, token.SHR_ASSIGN,
+			token.AND_NOT_ASSIGN:
+		default:
+			v.errorf(n, path, "invalid AssignStmt.Tok %s", n.Tok)
+		}
+		if len(n.Lhs) == 0 || len(n.Rhs) == 0 {
+			v.errorf(n, path, "AssignStmt needs Lhs and Rhs")
+		}
+		if n.Tok == token.DEFINE {
+			for _, e := range n.Lhs {
+				if _, ok := e.(*Ident); !ok && !isNilNode(e) {
+					v.errorf(n, path, "AssignStmt with %s has %T in Lhs", n.Tok, e)
+				}
+			}
+		}
+	case *IncDecStmt:
+		if n.Tok != token.INC && n.Tok != token.DEC {
+			v.errorf(n, path, "invalid IncDecStmt.Tok %s", n.Tok)
+		}
+	case *BranchStmt:
+		switch n.Tok {
+		case token.BREAK, token.CONTINUE:
+		case token.GOTO:
+			if n.Label == nil {
+				v.errorf(n, path, "BranchStmt with %s needs a Label", n.Tok)
+			}
+		case token.FALLTHROUGH:
+			if n.Label != nil {
+				v.errorf(n, path, "BranchStmt with %s can't have a Label", n.Tok)
+			}
+		default:
+			v.errorf(n, path, "invalid BranchStmt.Tok %s", n.Tok)
+		}
+	case *RangeStmt:
+		switch {
+		case n.Key == nil && n.Tok != token.ILLEGAL:
+			v.errorf(n, path, "RangeStmt.Tok is %s but Key is nil", n.Tok)
+		case n.Key != nil && n.Tok != token.ASSIGN && n.Tok != token.DEFINE:
+			v.errorf(n, path, "RangeStmt.Tok is %s but Key is set", n.Tok)
+		}
+		if n.Key == nil && n.Value != nil {
+			v.errorf(n, path, "RangeStmt.Value is set but Key is nil")
+		}
+	case *SwitchStmt:
+		v.clauseBody(n, path, "SwitchStmt", n.Body, false)
+	case *TypeSwitchStmt:
+		v.clauseBody(n, path, "TypeSwitchStmt", n.Body, false)
+	case *SelectStmt:
+		v.clauseBody(n, path, "SelectStmt", n.Body, true)
+	case *CaseClause:
+		if !v.clauses[n] && parentName != "" {
+			v.errorf(n, path, "CaseClause outside a switch statement")
+		}
+	case *CommClause:
+		if !v.clauses[n] && parentName != "" {
+			v.errorf(n, path, "CommClause outside a select statement")
+		}
+	case *CommentStmt:
+		if !stmtLists[parentName+"."+parentField] && parentName != "" {
+			v.errorf(n, path, "CommentStmt outside a list of statements")
+		}
+	case *CommentDecl:
+		if parentName+"."+parentField != "File.Decls" && parentName != "" {
+			v.errorf(n, path, "CommentDecl outside File.Decls")
+		}
+	case *GenDecl:
+		switch n.Tok {
+		case token.IMPORT, token.CONST, token.TYPE, token.VAR:
+		default:
+			v.errorf(n, path, "invalid GenDecl.Tok %s", n.Tok)
+			return
+		}
+		for i, s := range n.Specs {
+			var ok bool
+			switch s.(type) {
+			case *ImportSpec:
+				ok = n.Tok == token.IMPORT
+			case *ValueSpec:
+				ok = n.Tok == token.CONST || n.Tok == token.VAR
+			case *TypeSpec:
+				ok = n.Tok == token.TYPE
+			case nil:
+				ok = true // reported as a nil node
+			}
+			if !ok {
+				v.errorf(n, path, "GenDecl with %s has %T in Specs[%d]", n.Tok, s, i)
+			}
+		}
+	case *FuncDecl:
+		if n.Name == nil {
+			v.errorf(n, path, "FuncDecl.Name is nil")
+		}
+		if n.Type == nil {
+			v.errorf(n, path, "FuncDecl.Type is nil")
+		}
+	case *File:
+		if n.Name == nil {
+			v.errorf(n, path, "File.Name is nil")
+		}
+	}
+}
+
+// clauseBody checks that the body of a switch or select statement only contains case clauses (or
+// comm clauses if comm is true), and records the clauses so they aren't reported when they are
+// visited.
+func (v *validator) clauseBody(n Node, path, name string, body *BlockStmt, comm bool) {
+	if body == nil {
+		v.errorf(n, path, "%s.Body is nil", name)
+		return
+	}
+	for i, s := range body.List {
+		var ok bool
+		switch s.(type) {
+		case *CaseClause:
+			ok = !comm
+		case *CommClause:
+			ok = comm
+		case *CommentStmt:
+			continue
+		case nil:
+			continue // reported as a nil node
+		}
+		if !ok {
+			v.errorf(n, path, "%s.Body.List[%d] is %T", name, i, s)
+			continue
+		}
+		v.clauses[s] = true
+	}
+}
+
+// stmtLists lists the fields that are lists of statements, which are the only fields that can
+// contain a CommentStmt.
+var stmtLists = map[string]bool{
+	"BlockStmt.List":  true,
+	"CaseClause.Body": true,
+	"CommClause.Body": true,
+}
+
+// unqualified lists the fields where an Ident can't be a qualified identifier, so it can't have a
+// Path. This matches the fields that the decorator never resolves.
+var unqualified = map[string]bool{
+	"Field.Names":       true,
+	"LabeledStmt.Label": true,
+	"BranchStmt.Label":  true,
+	"ImportSpec.Name":   true,
+	"ValueSpec.Names":   true,
+	"TypeSpec.Name":     true,
+	"FuncDecl.Name":     true,
+	"File.Name":         true,
+	"SelectorExpr.Sel":  true,
+}
+
+func isNilNode(n Node) bool {
+	if n == nil {
+		return true
+	}
+	v := reflect.ValueOf(n)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+func fieldPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func indexPath(path, field string, index int) string {
+	return fieldPath(path, field) + "[" + strconv.Itoa(index) + "]"
+}
+
+func keyPath(path, field, key string) string {
+	return fieldPath(path, field) + "[" + strconv.Quote(key) + "]"
+}
+
+func pathString(path string) string {
+	if path == "" {
+		return "the root"
+	}
+	return path
+}
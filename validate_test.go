@@ -0,0 +1,124 @@
+package dst_test
+
+import (
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   string
+		mutate func(f *dst.File)
+		expect []string
+	}{
+		{
+			name: "valid",
+			code: "package a\n\nimport . \"fmt\"\n\n// a\nfunc a(b chan int) {\n\tswitch c := <-b; c {\n\tcase 1:\n\t\tfallthrough\n\tdefault:\n\t}\n\tfor i, v := range []int{1} {\n\t\tPrintln(i, v) // b\n\t}\n}\n",
+		},
+		{
+			name: "shared-node",
+			code: "package a\n\nvar a = b + c\n",
+			mutate: func(f *dst.File) {
+				e := f.Decls[0].(*dst.GenDecl).Specs[0].(*dst.ValueSpec).Values[0].(*dst.BinaryExpr)
+				e.Y = e.X
+			},
+			expect: []string{"Decls[0].Specs[0].Values[0].Y: *dst.Ident is also at Decls[0].Specs[0].Values[0].X"},
+		},
+		{
+			name: "gen-decl-tok",
+			code: "package a\n\nvar a = 1\n",
+			mutate: func(f *dst.File) {
+				f.Decls[0].(*dst.GenDecl).Tok = token.TYPE
+			},
+			expect: []string{"Decls[0]: GenDecl with type has *dst.ValueSpec in Specs[0]"},
+		},
+		{
+			name: "range-tok",
+			code: "package a\n\nfunc a() {\n\tfor i := range b {\n\t}\n}\n",
+			mutate: func(f *dst.File) {
+				f.Decls[0].(*dst.FuncDecl).Body.List[0].(*dst.RangeStmt).Key = nil
+			},
+			expect: []string{"Decls[0].Body.List[0]: RangeStmt.Tok is := but Key is nil"},
+		},
+		{
+			name: "case-outside-switch",
+			code: "package a\n\nfunc a() {\n\tswitch {\n\tcase b:\n\t}\n}\n",
+			mutate: func(f *dst.File) {
+				body := f.Decls[0].(*dst.FuncDecl).Body
+				body.List[0] = body.List[0].(*dst.SwitchStmt).Body.List[0]
+			},
+			expect: []string{"Decls[0].Body.List[0]: CaseClause outside a switch statement"},
+		},
+		{
+			name: "statement-in-switch",
+			code: "package a\n\nfunc a() {\n\tswitch {\n\tcase b:\n\t}\n}\n",
+			mutate: func(f *dst.File) {
+				s := f.Decls[0].(*dst.FuncDecl).Body.List[0].(*dst.SwitchStmt)
+				s.Body.List = append(s.Body.List, &dst.ExprStmt{X: dst.NewIdent("c")})
+			},
+			expect: []string{"Decls[0].Body.List[0]: SwitchStmt.Body.List[1] is *dst.ExprStmt"},
+		},
+		{
+			name: "comment-stmt-outside-list",
+			code: "package a\n\nfunc a() {\n\tif b {\n\t}\n}\n",
+			mutate: func(f *dst.File) {
+				s := f.Decls[0].(*dst.FuncDecl).Body.List[0].(*dst.IfStmt)
+				s.Init = &dst.CommentStmt{}
+				s.Body.List = append(s.Body.List, &dst.CommentStmt{})
+			},
+			expect: []string{"Decls[0].Body.List[0].Init: CommentStmt outside a list of statements"},
+		},
+		{
+			name: "path-on-func-name",
+			code: "package a\n\nfunc a() {}\n",
+			mutate: func(f *dst.File) {
+				f.Decls[0].(*dst.FuncDecl).Name.Path = "fmt"
+			},
+			expect: []string{"Decls[0].Name: Path fmt set on Ident a in FuncDecl.Name"},
+		},
+		{
+			name: "decorations",
+			code: "package a\n\nfunc a() {}\n",
+			mutate: func(f *dst.File) {
+				f.Decls[0].Decorations().Start.Append("// a\nb", "/* c */ d", "e")
+			},
+			expect: []string{
+				"Decls[0]: decoration \"// a\\nb\" in Start is not a newline or a comment",
+				"Decls[0]: decoration \"/* c */ d\" in Start is not a newline or a comment",
+				"Decls[0]: decoration \"e\" in Start is not a newline or a comment",
+			},
+		},
+		{
+			name: "nil-in-list",
+			code: "package a\n\nvar a = []int{1}\n",
+			mutate: func(f *dst.File) {
+				lit := f.Decls[0].(*dst.GenDecl).Specs[0].(*dst.ValueSpec).Values[0].(*dst.CompositeLit)
+				lit.Elts = append(lit.Elts, nil)
+			},
+			expect: []string{"Decls[0].Specs[0].Values[0].Elts[1]: nil node in CompositeLit.Elts"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := decorator.Parse(test.code)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.mutate != nil {
+				test.mutate(f)
+			}
+			var found []string
+			for _, err := range dst.Validate(f) {
+				found = append(found, err.Error())
+			}
+			if strings.Join(found, "\n") != strings.Join(test.expect, "\n") {
+				t.Errorf("expected:\n%s\nfound:\n%s", strings.Join(test.expect, "\n"), strings.Join(found, "\n"))
+			}
+		})
+	}
+}
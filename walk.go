@@ -177,7 +177,7 @@ func Walk(v Visitor, node Node) {
 	case *DeclStmt:
 		Walk(v, n.Decl)
 
-	case *EmptyStmt:
+	case *EmptyStmt, *CommentStmt:
 		// nothing to do
 
 	case *LabeledStmt:
@@ -297,7 +297,7 @@ func Walk(v Visitor, node Node) {
 		}
 		Walk(v, n.Type)
 
-	case *BadDecl:
+	case *BadDecl, *CommentDecl:
 		// nothing to do
 
 	case *GenDecl: